@@ -0,0 +1,212 @@
+package datefmt
+
+import (
+	"math"
+	"time"
+)
+
+// The astronomical algorithms used by the lunisolar calendars, ported from
+// Calendrical Calculations by Reingold and Dershowitz. Moments are fixed days
+// with fractions, in universal time unless noted otherwise.
+
+const (
+	meanTropicalYear = 365.242189
+	meanSynodicMonth = 29.530588861
+
+	// j2000 is the moment of noon on 2000-01-01.
+	j2000 = 730120.5
+)
+
+func sinDeg(x float64) float64 {
+	return math.Sin(x * math.Pi / 180)
+}
+
+func cosDeg(x float64) float64 {
+	return math.Cos(x * math.Pi / 180)
+}
+
+func modFloat(x, y float64) float64 {
+	return x - y*math.Floor(x/y)
+}
+
+// gregorianYearOf returns the Gregorian year of the fixed day rd.
+func gregorianYearOf(rd int) int {
+	return fromRataDie(rd, time.UTC).Year()
+}
+
+// ephemerisCorrection returns the difference between dynamical time and universal time in days.
+func ephemerisCorrection(tee float64) float64 {
+	year := gregorianYearOf(int(math.Floor(tee)))
+	y := float64(year)
+	c := float64(rataDieOf(year, 7, 1)-rataDieOf(1900, 1, 1)) / 36525
+	switch {
+	case year >= 2051 && year <= 2150:
+		x := (y - 1820) / 100
+		return (-20 + 32*x*x + 0.5628*(2150-y)) / 86400
+	case year >= 2006 && year <= 2050:
+		x := y - 2000
+		return (62.92 + 0.32217*x + 0.005589*x*x) / 86400
+	case year >= 1987 && year <= 2005:
+		x := y - 2000
+		return (63.86 + x*(0.3345+x*(-0.060374+x*(0.0017275+x*(0.000651814+x*0.00002373599))))) / 86400
+	case year >= 1900 && year <= 1986:
+		return -0.00002 + c*(0.000297+c*(0.025184+c*(-0.181133+c*(0.553040+c*(-0.861938+c*(0.677066+c*-0.212591))))))
+	case year >= 1800 && year <= 1899:
+		return -0.000009 + c*(0.003844+c*(0.083563+c*(0.865736+c*(4.867575+c*(15.845535+c*(31.332267+c*(38.291999+c*(28.316289+c*(11.636204+c*2.043794)))))))))
+	case year >= 1700 && year <= 1799:
+		x := y - 1700
+		return (8.118780842 + x*(-0.005092142+x*(0.003336121+x*-0.0000266484))) / 86400
+	case year >= 1600 && year <= 1699:
+		x := y - 1600
+		return (120 + x*(-0.9808+x*(-0.01532+x*0.000140272128))) / 86400
+	case year >= 500 && year <= 1599:
+		x := (y - 1000) / 100
+		return (1574.2 + x*(-556.01+x*(71.23472+x*(0.319781+x*(-0.8503463+x*(-0.005050998+x*0.0083572073)))))) / 86400
+	case year >= -499 && year <= 499:
+		x := y / 100
+		return (10583.6 + x*(-1014.41+x*(33.78311+x*(-5.952053+x*(-0.1798452+x*(0.022174192+x*0.0090316521)))))) / 86400
+	default:
+		x := float64(rataDieOf(year, 1, 1)-rataDieOf(1820, 1, 1)) / 36525
+		return (-20 + 32*x*x) / 86400
+	}
+}
+
+func julianCenturies(tee float64) float64 {
+	return (tee + ephemerisCorrection(tee) - j2000) / 36525
+}
+
+var solarLongitudeTerms = [...][3]float64{
+	{403406, 270.54861, 0.9287892}, {195207, 340.19128, 35999.1376958},
+	{119433, 63.91854, 35999.4089666}, {112392, 331.26220, 35998.7287385},
+	{3891, 317.843, 71998.20261}, {2819, 86.631, 71998.4403},
+	{1721, 240.052, 36000.35726}, {660, 310.26, 71997.4812},
+	{350, 247.23, 32964.4678}, {334, 260.87, -19.4410},
+	{314, 297.82, 445267.1117}, {268, 343.14, 45036.8840},
+	{242, 166.79, 3.1008}, {234, 81.53, 22518.4434},
+	{158, 3.50, -19.9739}, {132, 132.75, 65928.9345},
+	{129, 182.95, 9038.0293}, {114, 162.03, 3034.7684},
+	{99, 29.8, 33718.148}, {93, 266.4, 3034.448},
+	{86, 249.2, -2280.773}, {78, 157.6, 29929.992},
+	{72, 257.8, 31556.493}, {68, 185.1, 149.588},
+	{64, 69.9, 9037.750}, {46, 8.0, 107997.405},
+	{38, 197.1, -4444.176}, {37, 250.4, 151.771},
+	{32, 65.3, 67555.316}, {29, 162.7, 31556.080},
+	{28, 341.5, -4561.540}, {27, 291.6, 107996.706},
+	{27, 98.5, 1221.655}, {25, 146.7, 62894.167},
+	{24, 110.0, 31437.369}, {21, 5.2, 14578.298},
+	{21, 342.6, -31931.757}, {20, 230.9, 34777.243},
+	{18, 256.1, 1221.999}, {17, 45.3, 62894.511},
+	{14, 242.9, -4442.039}, {13, 115.2, 107997.909},
+	{13, 151.8, 119.066}, {13, 285.3, 16859.071},
+	{12, 53.3, -4.578}, {10, 126.6, 26895.292},
+	{10, 205.7, -39.127}, {10, 85.9, 12297.536},
+	{10, 146.1, 90073.778},
+}
+
+// solarLongitude returns the longitude of the sun in degrees at the moment tee.
+func solarLongitude(tee float64) float64 {
+	c := julianCenturies(tee)
+	sum := 0.0
+	for _, term := range solarLongitudeTerms {
+		sum += term[0] * sinDeg(term[1]+term[2]*c)
+	}
+	lambda := 282.7771834 + 36000.76953744*c + 0.000005729577951308232*sum
+	aberration := 0.0000974*cosDeg(177.63+35999.01848*c) - 0.005575
+	a := 124.90 - 1934.134*c + 0.002063*c*c
+	b := 201.11 + 72001.5377*c + 0.00057*c*c
+	nutation := -0.004778*sinDeg(a) - 0.0003667*sinDeg(b)
+	return modFloat(lambda+aberration+nutation, 360)
+}
+
+// solarLongitudeAfter returns the first moment after tee when the longitude of the sun is lambda.
+func solarLongitudeAfter(lambda, tee float64) float64 {
+	rate := meanTropicalYear / 360
+	tau := tee + rate*modFloat(lambda-solarLongitude(tee), 360)
+	lo, hi := math.Max(tee, tau-5), tau+5
+	for hi-lo >= 1e-5 {
+		mid := (lo + hi) / 2
+		if modFloat(solarLongitude(mid)-lambda, 360) < 180 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// estimatePriorSolarLongitude returns an approximate moment at or before tee
+// when the longitude of the sun was lambda.
+func estimatePriorSolarLongitude(lambda, tee float64) float64 {
+	rate := meanTropicalYear / 360
+	tau := tee - rate*modFloat(solarLongitude(tee)-lambda, 360)
+	delta := modFloat(solarLongitude(tau)-lambda+180, 360) - 180
+	return math.Min(tee, tau-rate*delta)
+}
+
+var newMoonTerms = [...]struct {
+	v          float64
+	e, x, y, z float64
+}{
+	{-0.40720, 0, 0, 1, 0}, {0.17241, 1, 1, 0, 0}, {0.01608, 0, 0, 2, 0},
+	{0.01039, 0, 0, 0, 2}, {0.00739, 1, -1, 1, 0}, {-0.00514, 1, 1, 1, 0},
+	{0.00208, 2, 2, 0, 0}, {-0.00111, 0, 0, 1, -2}, {-0.00057, 0, 0, 1, 2},
+	{0.00056, 1, 1, 2, 0}, {-0.00042, 0, 0, 3, 0}, {0.00042, 1, 1, 0, 2},
+	{0.00038, 1, 1, 0, -2}, {-0.00024, 1, -1, 2, 0}, {-0.00007, 0, 2, 1, 0},
+	{0.00004, 0, 0, 2, -2}, {0.00004, 0, 3, 0, 0}, {0.00003, 0, 1, 1, -2},
+	{0.00003, 0, 0, 2, 2}, {-0.00003, 0, 1, 1, 2}, {0.00003, 0, -1, 1, 2},
+	{-0.00002, 0, -1, 1, -2}, {-0.00002, 0, 1, 3, 0}, {0.00002, 0, 0, 4, 0},
+}
+
+var newMoonAdditionalTerms = [...][3]float64{
+	{251.88, 0.016321, 0.000165}, {251.83, 26.651886, 0.000164},
+	{349.42, 36.412478, 0.000126}, {84.66, 18.206239, 0.000110},
+	{141.74, 53.303771, 0.000062}, {207.14, 2.453732, 0.000060},
+	{154.84, 7.306860, 0.000056}, {34.52, 27.261239, 0.000047},
+	{207.19, 0.121824, 0.000042}, {291.34, 1.844379, 0.000040},
+	{161.72, 24.198154, 0.000037}, {239.56, 25.513099, 0.000035},
+	{331.55, 3.592518, 0.000023},
+}
+
+// nthNewMoon returns the moment of the k-th new moon after (or before if k is negative)
+// the first new moon of 2000.
+func nthNewMoon(k int) float64 {
+	kf := float64(k)
+	c := kf / 1236.85
+	approx := j2000 + 5.09766 + meanSynodicMonth*1236.85*c + c*c*(0.00015437+c*(-0.000000150+c*0.00000000073))
+	e := 1 - 0.002516*c - 0.0000074*c*c
+	solarAnomaly := 2.5534 + 29.10535670*1236.85*c + c*c*(-0.0000014-0.00000011*c)
+	lunarAnomaly := 201.5643 + 385.81693528*1236.85*c + c*c*(0.0107582+c*(0.00001238-0.000000058*c))
+	moonArgument := 160.7108 + 390.67050284*1236.85*c + c*c*(-0.0016118+c*(-0.00000227+0.000000011*c))
+	omega := 124.7746 - 1.56375588*1236.85*c + c*c*(0.0020672+0.00000215*c)
+	correction := -0.00017 * sinDeg(omega)
+	for _, term := range newMoonTerms {
+		correction += term.v * math.Pow(e, term.e) * sinDeg(term.x*solarAnomaly+term.y*lunarAnomaly+term.z*moonArgument)
+	}
+	extra := 0.000325 * sinDeg(299.77+132.8475848*c-0.009173*c*c)
+	additional := 0.0
+	for _, term := range newMoonAdditionalTerms {
+		additional += term[2] * sinDeg(term[0]+term[1]*kf)
+	}
+	tee := approx + correction + extra + additional
+	return tee - ephemerisCorrection(tee)
+}
+
+// newMoonIndexAtOrAfter returns the index of the first new moon at or after tee.
+func newMoonIndexAtOrAfter(tee float64) int {
+	k := int(math.Round((tee - j2000 - 5.09766) / meanSynodicMonth))
+	for nthNewMoon(k) >= tee {
+		k--
+	}
+	for nthNewMoon(k) < tee {
+		k++
+	}
+	return k
+}
+
+func newMoonAtOrAfter(tee float64) float64 {
+	return nthNewMoon(newMoonIndexAtOrAfter(tee))
+}
+
+func newMoonBefore(tee float64) float64 {
+	return nthNewMoon(newMoonIndexAtOrAfter(tee) - 1)
+}
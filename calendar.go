@@ -0,0 +1,380 @@
+package datefmt
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Calendar is a calendar system used by a Layout to format and parse
+// the era, year, month and day fields, see WithCalendar.
+type Calendar interface {
+	// String returns the CLDR identifier of the calendar, e.g. "japanese".
+	String() string
+
+	// Date returns the date of t in the calendar, in the location of t.
+	Date(t time.Time) CalendarDate
+
+	// Time returns the beginning of the date d in loc.
+	// If d.Month is 0, the date is resolved from d.YearDay instead of d.Month and d.Day.
+	Time(d CalendarDate, loc *time.Location) (time.Time, error)
+
+	// Eras returns the number of eras, which are numbered from 0.
+	Eras() int
+
+	// EraName returns the name of era in the given width.
+	EraName(era int, width NameWidth) string
+
+	// Months returns the max number of months in a year.
+	Months() int
+
+	// MonthName returns the name of the month of d in the given width.
+	MonthName(d CalendarDate, width NameWidth) string
+}
+
+// CalendarDate is a date in a calendar system.
+type CalendarDate struct {
+	Era       int
+	Year      int // year of era
+	Month     int
+	LeapMonth bool // the month is a leap month, see Chinese
+	Day       int
+	YearDay   int
+}
+
+// NameWidth is the width of a name.
+type NameWidth int
+
+const (
+	NameShort NameWidth = iota
+	NameLong
+	NameNarrow
+)
+
+var errEraRange = errors.New("date out of era range")
+
+func eraNameWidth(w int) NameWidth {
+	switch {
+	case w <= 3:
+		return NameShort
+	case w == 4:
+		return NameLong
+	default:
+		return NameNarrow
+	}
+}
+
+func monthNameWidth(w int) NameWidth {
+	if w <= 3 {
+		return NameShort
+	}
+	return NameLong
+}
+
+// gregorianMonthName returns the name of month in the Gregorian calendar.
+func gregorianMonthName(month int, width NameWidth) string {
+	if month < 1 || month > 12 {
+		return ""
+	}
+	switch width {
+	case NameShort:
+		return shortMonthNames[month-1]
+	case NameNarrow:
+		return longMonthNames[month-1][:1]
+	default:
+		return longMonthNames[month-1]
+	}
+}
+
+var calendarPlaceholders = map[byte]*placeholder{
+	'G': {max: fixedMax(0), flag: formatFlagCalendarEra, parse: parseCalendarEra},
+	'y': {max: calendarYearMax, flag: formatFlagCalendarYear, format: formatYear, parse: parseCalendarYear, numeric: anyWidth},
+	'M': {max: numberMax(2), flag: formatFlagCalendarMonth, format: formatNumProbably2Digits, parse: parseCalendarMonth, numeric: widthBelow(3)},
+	'D': {max: numberMax(3), flag: formatFlagCalendarYearDay, format: formatNumProbably3Digits, parse: parseNum(FieldYearDay), numeric: anyWidth},
+	'd': {max: numberMax(2), flag: formatFlagCalendarDay, format: formatNumProbably2Digits, parse: parseNum(FieldDay), numeric: anyWidth},
+	'F': {max: numberMax(1), flag: formatFlagCalendarDay, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, dayOfWeekInMonth(v), w) }, parse: parseNum(FieldDayOfWeekInMonth), numeric: anyWidth},
+	'U': {max: fixedMax(0), flag: formatFlagCalendarCyclicYear, parse: parseCalendarCyclicYear},
+	'r': {max: extendedYearMax, flag: formatFlagCalendarRelatedYear, format: formatExtendedYear, parse: parseCalendarRelatedYear, numeric: anyWidth},
+}
+
+// calendarDayNamePlaceholder is the placeholder of ddd in calendars with day names.
+var calendarDayNamePlaceholder = placeholder{max: numberMax(2), flag: formatFlagCalendarDayName, parse: parseCalendarDayName}
+
+// newCalendarFormatArg returns the format arg of a placeholder resolved against cal.
+func newCalendarFormatArg(p []byte, cal Calendar) *formatArg {
+	c := p[0]
+	cyc, cyclic := cal.(cyclicCalendar)
+	if !cyclic {
+		switch c {
+		case 'U':
+			// no cyclic year names, fall back to the year
+			c = 'y'
+		case 'r':
+			// the related Gregorian year is the Gregorian year
+			return newPlaceholderFormatArg(p)
+		}
+	}
+	ph, ok := calendarPlaceholders[c]
+	if !ok {
+		return newPlaceholderFormatArg(p)
+	}
+	if c == 'd' && len(p) >= 3 && cyclic {
+		ph = &calendarDayNamePlaceholder
+	}
+	arg := &formatArg{s: readOnlyBytes2String(p), w: len(p), ph: *ph}
+	arg.max = ph.max(arg.w)
+	switch {
+	case ph.flag == formatFlagCalendarEra:
+		for era := 0; era < cal.Eras(); era++ {
+			arg.max = maxInt(arg.max, len(cal.EraName(era, eraNameWidth(arg.w))))
+		}
+	case ph.flag == formatFlagCalendarMonth && arg.w >= 3:
+		for month := 1; month <= cal.Months(); month++ {
+			d := CalendarDate{Year: 1, Month: month}
+			arg.max = maxInt(arg.max, len(cal.MonthName(d, monthNameWidth(arg.w))))
+			if cyclic {
+				d.LeapMonth = true
+				arg.max = maxInt(arg.max, len(cal.MonthName(d, monthNameWidth(arg.w))))
+			}
+		}
+	case ph.flag == formatFlagCalendarMonth && cyclic:
+		arg.max += len(cyc.leapMonth(""))
+	case ph.flag == formatFlagCalendarCyclicYear:
+		for year := 1; year <= 60; year++ {
+			arg.max = maxInt(arg.max, len(cyc.cyclicYearName(CalendarDate{Year: year})))
+		}
+	case ph.flag == formatFlagCalendarDayName:
+		for day := 1; day <= 30; day++ {
+			arg.max = maxInt(arg.max, len(cyc.dayName(CalendarDate{Day: day})))
+		}
+	}
+	return arg
+}
+
+func calendarYearMax(w int) int {
+	// years before the first era might be negative
+	return yearMax(w) + 1
+}
+
+// G Era
+
+func parseCalendarEra(ps *parser, value string, w int) (string, error) {
+	cal := ps.l.opts.calendar
+	if _, ok := cal.(cyclicCalendar); ok {
+		// the eras are numbered cycles
+		era, n, ok := getNum(value, w, ps.fixed)
+		if !ok {
+			return value, errBad
+		}
+		ps.f.Era = era - 1
+		ps.f.present |= FieldEra
+		return value[n:], nil
+	}
+	names := make([]string, 0, cal.Eras()*3)
+	for era := 0; era < cal.Eras(); era++ {
+		names = append(names, cal.EraName(era, NameLong), cal.EraName(era, NameShort), cal.EraName(era, NameNarrow))
+	}
+	i, rest, ok := lookupName(value, names)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Era = i / 3
+	ps.f.present |= FieldEra
+	return rest, nil
+}
+
+// y Year
+
+func parseCalendarYear(ps *parser, value string, w int) (string, error) {
+	sign, rest := 1, value
+	if len(rest) > 0 && rest[0] == '-' {
+		sign, rest = -1, rest[1:]
+	}
+	year, n, ok := getNum(rest, w, ps.fixed)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Year = sign * year
+	ps.f.present |= FieldYear
+	return rest[n:], nil
+}
+
+// M Month
+
+func parseCalendarMonth(ps *parser, value string, w int) (string, error) {
+	cal := ps.l.opts.calendar
+	cyc, cyclic := cal.(cyclicCalendar)
+	if w < 3 {
+		if !cyclic {
+			return parseNum(FieldMonth)(ps, value, w)
+		}
+		return parseLeapMonthNum(ps, value, w, cyc)
+	}
+	years := []int{ps.f.Year}
+	if lc, ok := cal.(leapYearCalendar); ok && !ps.f.Has(FieldYear) {
+		// the year is unknown, accept the names in both leap and common years
+		years = leapAndCommonYears(lc)
+	}
+	leaps := []bool{false}
+	if cyclic {
+		leaps = append(leaps, true)
+	}
+	d := CalendarDate{Era: ps.f.Era}
+	names := make([]string, 0, cal.Months()*len(leaps)*len(years)*2)
+	for month := 1; month <= cal.Months(); month++ {
+		d.Month = month
+		for _, d.LeapMonth = range leaps {
+			for _, d.Year = range years {
+				names = append(names, cal.MonthName(d, NameLong), cal.MonthName(d, NameShort))
+			}
+		}
+	}
+	i, rest, ok := lookupName(value, names)
+	if !ok {
+		return value, errBad
+	}
+	n := len(years) * 2
+	ps.f.Month = i/(len(leaps)*n) + 1
+	ps.f.LeapMonth = leaps[i/n%len(leaps)]
+	ps.f.present |= FieldMonth
+	return rest, nil
+}
+
+// parseLeapMonthNum parses a month number with the optional leap month marker of cyc.
+func parseLeapMonthNum(ps *parser, value string, w int, cyc cyclicCalendar) (string, error) {
+	pattern := cyc.leapMonth("\x00")
+	i := strings.IndexByte(pattern, 0)
+	prefix, suffix := pattern[:i], pattern[i+1:]
+
+	leap, rest := false, value
+	if prefix != "" && strings.HasPrefix(rest, prefix) {
+		leap, rest = true, rest[len(prefix):]
+	}
+	rest, err := parseNum(FieldMonth)(ps, rest, w)
+	if err != nil {
+		return value, err
+	}
+	if !leap && suffix != "" && strings.HasPrefix(rest, suffix) {
+		leap, rest = true, rest[len(suffix):]
+	}
+	ps.f.LeapMonth = leap
+	return rest, nil
+}
+
+// U Cyclic year
+
+func parseCalendarCyclicYear(ps *parser, value string, w int) (string, error) {
+	cyc := ps.l.opts.calendar.(cyclicCalendar)
+	names := make([]string, 60)
+	for i := range names {
+		names[i] = cyc.cyclicYearName(CalendarDate{Year: i + 1})
+	}
+	i, rest, ok := lookupName(value, names)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Year = i + 1
+	ps.f.present |= FieldYear
+	return rest, nil
+}
+
+// r Related Gregorian year
+
+func parseCalendarRelatedYear(ps *parser, value string, w int) (string, error) {
+	rest, err := parseExtendedYear(ps, value, w)
+	if err != nil {
+		return value, err
+	}
+	ps.f.Era, ps.f.Year = ps.l.opts.calendar.(cyclicCalendar).fromRelatedYear(ps.f.Year)
+	ps.f.present |= FieldEra
+	return rest, nil
+}
+
+// ddd Day name
+
+func parseCalendarDayName(ps *parser, value string, w int) (string, error) {
+	cyc := ps.l.opts.calendar.(cyclicCalendar)
+	if cyc.dayName(CalendarDate{Day: 1}) == "" {
+		return parseNum(FieldDay)(ps, value, w)
+	}
+	names := make([]string, 30)
+	for i := range names {
+		names[i] = cyc.dayName(CalendarDate{Day: i + 1})
+	}
+	i, rest, ok := lookupName(value, names)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Day = i + 1
+	ps.f.present |= FieldDay
+	return rest, nil
+}
+
+// gregorianMonthCalendar is implemented by calendars whose months and days are
+// the Gregorian ones, so that a date is determined by the Gregorian year.
+type gregorianMonthCalendar interface {
+	gregorianMonths() bool
+}
+
+// calendarDate converts the fields to a date of the calendar.
+func (f *Fields) calendarDate() CalendarDate {
+	d := CalendarDate{Era: f.cal.Eras() - 1, Year: 1, Month: 1, Day: 1}
+	if f.Has(FieldEra) {
+		d.Era = f.Era
+	}
+	if f.Has(FieldYear) {
+		d.Year = f.Year
+	}
+	if f.Has(FieldMonth) {
+		d.Month, d.LeapMonth = f.Month, f.LeapMonth
+	}
+	switch {
+	case f.Has(FieldDay):
+		d.Day = f.Day
+	case f.Has(FieldDayOfWeekInMonth):
+		d.Day = (f.DayOfWeekInMonth-1)*7 + 1
+	case f.Has(FieldYearDay) && !f.Has(FieldMonth):
+		d.Month, d.YearDay = 0, f.YearDay
+	}
+	return d
+}
+
+// rataDie returns the fixed day number of the date of t, the day 0001-01-01 is 1.
+func rataDie(t time.Time) int {
+	return rataDieOf(t.Date())
+}
+
+// rataDieOf returns the fixed day number of the Gregorian date.
+func rataDieOf(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()/86400) + rataDieUnixEpoch
+}
+
+// fromRataDie returns the beginning of the date with the fixed day number rd in loc.
+func fromRataDie(rd int, loc *time.Location) time.Time {
+	return time.Date(1970, time.January, 1+rd-rataDieUnixEpoch, 0, 0, 0, 0, loc)
+}
+
+// rataDieUnixEpoch is the fixed day number of 1970-01-01.
+const rataDieUnixEpoch = 719163
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,274 @@
+package datefmt
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Chinese is the Chinese lunisolar calendar, in which months begin with the
+// new moons and leap months are inserted to keep the years in sync with the
+// solar terms, computed astronomically in the time of Beijing.
+//
+// Years are numbered from 1 to 60 in the sexagenary cycles, which are the eras
+// numbered from 0 for the cycle beginning in 2637 BC. Eras reports the cycles
+// up to the current one beginning in 1984, which is the default era of parsing.
+// The related Gregorian year is formatted and parsed with r, and the cyclic
+// year name, e.g. 壬寅 with WithLocale("zh"), with U.
+//
+// A leap month has the same number as the month before it, with a leap marker
+// like 闰五月, or 5bis in English. With WithLocale("zh"), ddd formats the day
+// of month as a lunar day name, e.g. 廿二.
+var Chinese Calendar = chineseCalendar{}
+
+// cyclicCalendar is implemented by calendars with sexagenary cyclic years
+// and leap months, i.e. the Chinese calendar.
+type cyclicCalendar interface {
+	// cyclicYearName returns the name of the year of d in the sexagenary cycle.
+	cyclicYearName(d CalendarDate) string
+
+	// dayName returns the name of the day of d, or an empty string if there is none.
+	dayName(d CalendarDate) string
+
+	// leapMonth returns the leap month with the given month name or number.
+	leapMonth(month string) string
+
+	// relatedYear returns the Gregorian year in which the year of d begins.
+	relatedYear(d CalendarDate) int
+
+	// fromRelatedYear returns the era and year of the related Gregorian year.
+	fromRelatedYear(year int) (era, yearOfEra int)
+}
+
+var (
+	chineseStems      = []string{"jia", "yi", "bing", "ding", "wu", "ji", "geng", "xin", "ren", "gui"}
+	chineseBranches   = []string{"zi", "chou", "yin", "mao", "chen", "si", "wu", "wei", "shen", "you", "xu", "hai"}
+	chineseMonthNames = [3][]string{
+		{"Mo1", "Mo2", "Mo3", "Mo4", "Mo5", "Mo6", "Mo7", "Mo8", "Mo9", "Mo10", "Mo11", "Mo12"},
+		{"First Month", "Second Month", "Third Month", "Fourth Month", "Fifth Month", "Sixth Month", "Seventh Month", "Eighth Month", "Ninth Month", "Tenth Month", "Eleventh Month", "Twelfth Month"},
+		{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+	}
+)
+
+const (
+	// chineseEras is the number of the sexagenary cycles up to the one beginning in 1984.
+	chineseEras = 78
+
+	// chineseYearOffset is the related Gregorian year before the first year of the first cycle.
+	chineseYearOffset = -2637
+)
+
+type chineseCalendar struct{}
+
+func (chineseCalendar) String() string {
+	return "chinese"
+}
+
+func (c chineseCalendar) Date(t time.Time) CalendarDate {
+	rd := rataDie(t)
+	related := t.Year()
+	y := chineseYearOf(related)
+	if rd < y.start {
+		related--
+		y = chineseYearOf(related)
+	}
+	i := len(y.months) - 1
+	for y.months[i].start > rd {
+		i--
+	}
+	m := &y.months[i]
+	d := CalendarDate{Month: m.month, LeapMonth: m.leap, Day: rd - m.start + 1, YearDay: rd - y.start + 1}
+	d.Era, d.Year = c.fromRelatedYear(related)
+	return d
+}
+
+func (c chineseCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	if d.Year < 1 || d.Year > 60 {
+		return time.Time{}, errEraRange
+	}
+	y := chineseYearOf(c.relatedYear(d))
+	if d.Month == 0 {
+		if d.YearDay < 1 || d.YearDay > y.end-y.start {
+			return time.Time{}, errDayRange
+		}
+		return fromRataDie(y.start+d.YearDay-1, loc), nil
+	}
+	for i, m := range y.months {
+		if m.month != d.Month || m.leap != d.LeapMonth {
+			continue
+		}
+		end := y.end
+		if i+1 < len(y.months) {
+			end = y.months[i+1].start
+		}
+		if d.Day < 1 || d.Day > end-m.start {
+			return time.Time{}, errDayRange
+		}
+		return fromRataDie(m.start+d.Day-1, loc), nil
+	}
+	return time.Time{}, errMonthRange
+}
+
+func (chineseCalendar) Eras() int {
+	return chineseEras
+}
+
+func (chineseCalendar) EraName(era int, width NameWidth) string {
+	return strconv.Itoa(era + 1)
+}
+
+func (chineseCalendar) Months() int {
+	return 12
+}
+
+func (c chineseCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	name := chineseMonthNames[width][d.Month-1]
+	if d.LeapMonth {
+		return c.leapMonth(name)
+	}
+	return name
+}
+
+func (chineseCalendar) cyclicYearName(d CalendarDate) string {
+	i := mod(d.Year-1, 60)
+	return chineseStems[i%10] + "-" + chineseBranches[i%12]
+}
+
+func (chineseCalendar) dayName(d CalendarDate) string {
+	return ""
+}
+
+func (chineseCalendar) leapMonth(month string) string {
+	return month + "bis"
+}
+
+func (chineseCalendar) relatedYear(d CalendarDate) int {
+	return d.Era*60 + d.Year + chineseYearOffset
+}
+
+func (chineseCalendar) fromRelatedYear(year int) (era, yearOfEra int) {
+	elapsed := year - chineseYearOffset - 1
+	return floorDiv(elapsed, 60), mod(elapsed, 60) + 1
+}
+
+// chineseYear is a year of the Chinese calendar, from its new year to the next one.
+type chineseYear struct {
+	start, end int
+	months     []chineseMonth
+}
+
+type chineseMonth struct {
+	start int
+	month int
+	leap  bool
+}
+
+var chineseYearCache sync.Map
+
+// chineseYearOf returns the Chinese year beginning in the Gregorian year.
+func chineseYearOf(year int) *chineseYear {
+	if v, ok := chineseYearCache.Load(year); ok {
+		return v.(*chineseYear)
+	}
+	y := &chineseYear{
+		start: chineseNewYearInSui(rataDieOf(year, time.July, 1)),
+		end:   chineseNewYearInSui(rataDieOf(year+1, time.July, 1)),
+	}
+	for start := y.start; start < y.end; start = chineseNewMoonOnOrAfter(start + 1) {
+		month, leap := chineseMonthOf(start)
+		y.months = append(y.months, chineseMonth{start: start, month: month, leap: leap})
+	}
+	v, _ := chineseYearCache.LoadOrStore(year, y)
+	return v.(*chineseYear)
+}
+
+// chineseZone returns the offset of the time of Beijing in days,
+// which was the local mean time before 1929.
+func chineseZone(tee float64) float64 {
+	if gregorianYearOf(int(math.Floor(tee))) < 1929 {
+		return 1397.0 / 180 / 24
+	}
+	return 8.0 / 24
+}
+
+func chineseMidnight(date int) float64 {
+	return float64(date) - chineseZone(float64(date))
+}
+
+// chineseWinterSolsticeOnOrBefore returns the date of the winter solstice on or before the date.
+func chineseWinterSolsticeOnOrBefore(date int) int {
+	approx := estimatePriorSolarLongitude(270, chineseMidnight(date+1))
+	day := int(math.Floor(approx)) - 1
+	for solarLongitude(chineseMidnight(day+1)) <= 270 {
+		day++
+	}
+	return day
+}
+
+func chineseNewMoonOnOrAfter(date int) int {
+	tee := newMoonAtOrAfter(chineseMidnight(date))
+	return int(math.Floor(tee + chineseZone(tee)))
+}
+
+func chineseNewMoonBefore(date int) int {
+	tee := newMoonBefore(chineseMidnight(date))
+	return int(math.Floor(tee + chineseZone(tee)))
+}
+
+// chineseMajorSolarTerm returns the last major solar term on or before the date, from 1 to 12.
+func chineseMajorSolarTerm(date int) int {
+	s := solarLongitude(float64(date) - chineseZone(float64(date)))
+	return mod(1+int(math.Floor(s/30)), 12) + 1
+}
+
+// chineseNoMajorSolarTerm reports whether the month beginning on the date has no major solar term.
+func chineseNoMajorSolarTerm(date int) bool {
+	return chineseMajorSolarTerm(date) == chineseMajorSolarTerm(chineseNewMoonOnOrAfter(date+1))
+}
+
+// chinesePriorLeapMonth reports whether there is a leap month from the month
+// beginning on start to the month beginning on date.
+func chinesePriorLeapMonth(start, date int) bool {
+	for ; date >= start; date = chineseNewMoonBefore(date) {
+		if chineseNoMajorSolarTerm(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// chineseSui returns the start of the 12th month, the first month after the
+// winter solstice, of the sui containing the date, which is the period from a
+// winter solstice to the next, and whether the sui has a leap month.
+func chineseSui(date int) (m12 int, leap bool) {
+	s1 := chineseWinterSolsticeOnOrBefore(date)
+	s2 := chineseWinterSolsticeOnOrBefore(s1 + 370)
+	m12 = chineseNewMoonOnOrAfter(s1 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	return m12, math.Round(float64(nextM11-m12)/meanSynodicMonth) == 12
+}
+
+func chineseNewYearInSui(date int) int {
+	m12, leap := chineseSui(date)
+	m13 := chineseNewMoonOnOrAfter(m12 + 1)
+	if leap && (chineseNoMajorSolarTerm(m12) || chineseNoMajorSolarTerm(m13)) {
+		return chineseNewMoonOnOrAfter(m13 + 1)
+	}
+	return m13
+}
+
+// chineseMonthOf returns the month beginning on the date.
+func chineseMonthOf(date int) (month int, leap bool) {
+	m12, leapYear := chineseSui(date)
+	month = int(math.Round(float64(date-m12) / meanSynodicMonth))
+	if leapYear && chinesePriorLeapMonth(m12, date) {
+		month--
+	}
+	month = mod(month-1, 12) + 1
+	leap = leapYear && chineseNoMajorSolarTerm(date) && !chinesePriorLeapMonth(m12, chineseNewMoonBefore(date))
+	return month, leap
+}
@@ -0,0 +1,125 @@
+package datefmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func TestChineseFormat(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     time.Time
+		out    string
+	}{
+		{layout: "U年MMMddd", locale: "zh", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "壬寅年五月廿二"},
+		{layout: "rU年MMMMddd", locale: "zh-CN", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "2022壬寅年五月廿二"},
+		{layout: "r-MM-dd", locale: "zh", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "2022-05-22"},
+		{layout: "U MMMM d, r", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "ren-yin Fifth Month 22, 2022"},
+		{layout: "MMM ddd", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "Mo5 22"},
+		{layout: "G-y-M-d D", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "78-39-5-22 140"},
+		{layout: "U年MMMddd", locale: "zh", in: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC), out: "辛丑年腊月廿九"},
+		{layout: "U年MMMddd D", locale: "zh", in: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC), out: "壬寅年正月初一 1"},
+		{layout: "U年MMMddd", locale: "zh", in: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC), out: "庚子年闰四月初一"},
+		{layout: "r-MM-dd", locale: "zh", in: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC), out: "2020-闰04-01"},
+		{layout: "r-M-d", in: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC), out: "2020-4bis-1"},
+		{layout: "MMMM d", in: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC), out: "Fourth Monthbis 1"},
+		{layout: "U年MMMddd", locale: "zh", in: time.Date(2033, time.December, 22, 0, 0, 0, 0, time.UTC), out: "癸丑年闰冬月初一"},
+		{layout: "rU年MMMddd", locale: "zh", in: time.Date(1984, time.February, 2, 0, 0, 0, 0, time.UTC), out: "1984甲子年正月初一"},
+		{layout: "U年MMMddd HH:mm", locale: "zh", in: time.Date(2023, time.April, 20, 9, 49, 0, 0, time.UTC), out: "癸卯年三月初一 09:49"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Chinese), datefmt.WithLocale(c.locale))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+
+	// U and r of other calendars are the year and the Gregorian year
+	l := datefmt.NewLayout("U r", datefmt.WithCalendar(datefmt.Japanese))
+	if r := l.Format(time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)); r != "4 2022" {
+		t.Errorf("Format(U r) = %s; want 4 2022", r)
+	}
+
+	// leap months of other calendars are formatted as the plain month
+	l = datefmt.NewLayout("y-M-d", datefmt.WithCalendar(userCalendar{datefmt.Chinese}))
+	if r := l.Format(time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC)); r != "37-4-1" {
+		t.Errorf("Format(y-M-d) = %s; want 37-4-1", r)
+	}
+}
+
+// userCalendar is a calendar implemented outside of the package.
+type userCalendar struct {
+	datefmt.Calendar
+}
+
+func TestChineseParse(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     string
+		out    time.Time
+	}{
+		{layout: "rU年MMMddd", locale: "zh", in: "2022壬寅年五月廿二", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "U年MMMddd", locale: "zh", in: "壬寅年五月廿二", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "U年MMMddd", locale: "zh", in: "庚子年闰四月初一", out: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "U年MMMddd", locale: "zh", in: "庚子年四月初一", out: time.Date(2020, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "r-MM-dd", locale: "zh", in: "2020-闰04-01", out: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "r-M-d", in: "2020-4bis-1", out: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "r MMMM d", in: "2020 Fourth Monthbis 1", out: time.Date(2020, time.May, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "r MMMM d", in: "2020 fourth month 1", out: time.Date(2020, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{layout: "G-y-M-d", in: "78-39-5-22", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "U D", in: "ren-yin 1", out: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "rrrrMMdd", in: "20220522", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Chinese), datefmt.WithLocale(c.locale))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s, %s) returns error: %v", c.layout, c.locale, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"2022-闰05-01", "2022-13-01", "2022-04-30", "2022-00-01"} {
+		l := datefmt.NewLayout("r-MM-dd", datefmt.WithCalendar(datefmt.Chinese), datefmt.WithLocale("zh"))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+
+	l := datefmt.NewLayout("MMMddd", datefmt.WithCalendar(datefmt.Chinese), datefmt.WithLocale("zh"))
+	r, err := l.ParseWithDefaults("腊月廿九", time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC); !r.Equal(want) {
+		t.Errorf("ParseWithDefaults() = %s; want %s", r, want)
+	}
+}
+
+func TestChineseRoundTrip(t *testing.T) {
+	cal := datefmt.Chinese
+	prev := cal.Date(time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC))
+	for d := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+		cd := cal.Date(d)
+		if cd.Day != prev.Day+1 && (cd.Day != 1 || prev.Day < 29) {
+			t.Fatalf("Date(%s) = %v follows %v", d, cd, prev)
+		}
+		prev = cd
+		r, err := cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) = %s, %v", d, r, err)
+		}
+		cd.Month, cd.Day = 0, 0
+		r, err = cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) with year day = %s, %v", d, r, err)
+		}
+	}
+}
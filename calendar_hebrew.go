@@ -0,0 +1,155 @@
+package datefmt
+
+import "time"
+
+// Hebrew is the Hebrew (Jewish) lunisolar calendar, in which years are counted
+// from the creation (Anno Mundi). A year has 12 months, or 13 months in the
+// 7 leap years of a 19-year cycle, in which Adar I is inserted before Adar.
+//
+// Months are numbered like ICU, from Tishri (1) to Elul (13), where Adar I (6)
+// only exists in leap years and Adar (7) is called Adar II in leap years.
+// The month names are transliterated, and in Hebrew with WithLocale("he").
+var Hebrew Calendar = hebrewCalendar{}
+
+var (
+	hebrewEraNames   = [3]string{"AM", "AM", "AM"}
+	hebrewMonthNames = []string{"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar", "Nisan", "Iyar", "Sivan", "Tamuz", "Av", "Elul"}
+)
+
+const (
+	hebrewAdarI = 6
+	hebrewAdar  = 7
+
+	// hebrewEpoch is the fixed day number of 1 Tishri 1 AM, 7 October 3761 BC in the Julian calendar.
+	hebrewEpoch = -1373427
+)
+
+// hebrewCalendar is an arithmetic Hebrew calendar,
+// see Calendrical Calculations by Reingold and Dershowitz.
+type hebrewCalendar struct{}
+
+func (hebrewCalendar) String() string {
+	return "hebrew"
+}
+
+func (hebrewCalendar) Date(t time.Time) CalendarDate {
+	rd := rataDie(t)
+	year := floorDiv((rd-hebrewEpoch)*98496, 35975351)
+	for hebrewNewYear(year+1) <= rd {
+		year++
+	}
+	d := CalendarDate{Year: year, Month: 1, YearDay: rd - hebrewNewYear(year) + 1}
+	d.Day = d.YearDay
+	for n := hebrewDaysIn(d.Month, year); d.Day > n; n = hebrewDaysIn(d.Month, year) {
+		d.Day -= n
+		d.Month++
+	}
+	return d
+}
+
+func (hebrewCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	if d.Era != 0 {
+		return time.Time{}, errEraRange
+	}
+	if d.Month == 0 {
+		if d.YearDay < 1 || d.YearDay > hebrewDaysInYear(d.Year) {
+			return time.Time{}, errDayRange
+		}
+		return fromRataDie(hebrewNewYear(d.Year)+d.YearDay-1, loc), nil
+	}
+	if d.Month < 1 || d.Month > 13 || (d.Month == hebrewAdarI && !hebrewIsLeapYear(d.Year)) {
+		return time.Time{}, errMonthRange
+	}
+	if d.Day < 1 || d.Day > hebrewDaysIn(d.Month, d.Year) {
+		return time.Time{}, errDayRange
+	}
+	rd := hebrewNewYear(d.Year) + d.Day - 1
+	for month := 1; month < d.Month; month++ {
+		rd += hebrewDaysIn(month, d.Year)
+	}
+	return fromRataDie(rd, loc), nil
+}
+
+func (hebrewCalendar) Eras() int {
+	return 1
+}
+
+func (hebrewCalendar) EraName(era int, width NameWidth) string {
+	if era != 0 {
+		return ""
+	}
+	return hebrewEraNames[width]
+}
+
+func (hebrewCalendar) Months() int {
+	return 13
+}
+
+func (hebrewCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if d.Month < 1 || d.Month > 13 {
+		return ""
+	}
+	if d.Month == hebrewAdar && hebrewIsLeapYear(d.Year) {
+		return "Adar II"
+	}
+	return hebrewMonthNames[d.Month-1]
+}
+
+func (hebrewCalendar) isLeapYear(d CalendarDate) bool {
+	return hebrewIsLeapYear(d.Year)
+}
+
+func hebrewIsLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// hebrewElapsedDays returns the number of days from the epoch to the new year,
+// without the delays of the year lengths.
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	delay := 0
+	if ny2-ny1 == 356 {
+		delay = 2
+	} else if ny1-ny0 == 382 {
+		delay = 1
+	}
+	return hebrewEpoch + ny1 + delay
+}
+
+func hebrewDaysInYear(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func hebrewDaysIn(month, year int) int {
+	switch month {
+	case 2: // Heshvan is long in complete years
+		if n := hebrewDaysInYear(year); n == 355 || n == 385 {
+			return 30
+		}
+		return 29
+	case 3: // Kislev is short in deficient years
+		if n := hebrewDaysInYear(year); n == 353 || n == 383 {
+			return 29
+		}
+		return 30
+	case hebrewAdarI:
+		if hebrewIsLeapYear(year) {
+			return 30
+		}
+		return 0
+	case 1, 5, 8, 10, 12:
+		return 30
+	default:
+		return 29
+	}
+}
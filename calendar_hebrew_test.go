@@ -0,0 +1,114 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleWithLocale() {
+	t := time.Date(2022, time.March, 17, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.NewLayout("d MMMM y", datefmt.WithCalendar(datefmt.Hebrew)).Format(t))
+	fmt.Println(datefmt.NewLayout("d MMMM y", datefmt.WithCalendar(datefmt.Hebrew), datefmt.WithLocale("he-IL")).Format(t))
+	// Output:
+	// 14 Adar II 5782
+	// 14 אדר ב׳ 5782
+}
+
+func TestHebrewFormat(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     time.Time
+		out    string
+	}{
+		{layout: "d MMMM y G", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "21 Sivan 5782 AM"},
+		{layout: "y-MM-dd D", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "5782-10-21 287"},
+		{layout: "d MMMM y", in: time.Date(2021, time.September, 7, 0, 0, 0, 0, time.UTC), out: "1 Tishri 5782"},
+		{layout: "d MMMM y", in: time.Date(2022, time.September, 26, 0, 0, 0, 0, time.UTC), out: "1 Tishri 5783"},
+		{layout: "d MMMM y", in: time.Date(2022, time.February, 10, 0, 0, 0, 0, time.UTC), out: "9 Adar I 5782"},
+		{layout: "d MMMM y", in: time.Date(2022, time.March, 17, 0, 0, 0, 0, time.UTC), out: "14 Adar II 5782"},
+		{layout: "d MMMM y", in: time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC), out: "14 Adar 5783"},
+		{layout: "d MMMM y", in: time.Date(2022, time.December, 24, 0, 0, 0, 0, time.UTC), out: "30 Kislev 5783"},
+		{layout: "d MMMM y", in: time.Date(2023, time.December, 13, 0, 0, 0, 0, time.UTC), out: "1 Tevet 5784"},
+		{layout: "d MMMM y GGGG", locale: "he", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "21 סיוון 5782 לבריאת העולם"},
+		{layout: "d MMMM y", locale: "he", in: time.Date(2022, time.February, 10, 0, 0, 0, 0, time.UTC), out: "9 אדר א׳ 5782"},
+		{layout: "d MMMM y", locale: "he", in: time.Date(2022, time.March, 17, 0, 0, 0, 0, time.UTC), out: "14 אדר ב׳ 5782"},
+		{layout: "d MMMM y", locale: "he", in: time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC), out: "14 אדר 5783"},
+		{layout: "d MMMM y", locale: "fr", in: time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC), out: "14 Adar 5783"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Hebrew), datefmt.WithLocale(c.locale))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+}
+
+func TestHebrewParse(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     string
+		out    time.Time
+	}{
+		{layout: "d MMMM y G", in: "21 Sivan 5782 AM", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "y-MM-dd", in: "5782-10-21", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", in: "9 Adar I 5782", out: time.Date(2022, time.February, 10, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", in: "14 Adar II 5782", out: time.Date(2022, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", in: "14 Adar 5783", out: time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC)},
+		{layout: "y D", in: "5783 1", out: time.Date(2022, time.September, 26, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", locale: "he", in: "14 אדר ב׳ 5782", out: time.Date(2022, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", locale: "he", in: "21 סיוון 5782", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Hebrew), datefmt.WithLocale(c.locale))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s, %s) returns error: %v", c.layout, c.locale, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"1 Adar I 5783", "30 Kislev 5784", "30 Elul 5782", "1 Nisan 5782 BC"} {
+		l := datefmt.NewLayout("d MMMM y", datefmt.WithCalendar(datefmt.Hebrew))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+
+	l := datefmt.NewLayout("d MMMM", datefmt.WithCalendar(datefmt.Hebrew))
+	if _, err := l.Parse("1 Tamuz"); err == nil {
+		t.Errorf("Parse(d MMMM, 1 Tamuz) should return error")
+	}
+	ref := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	if r, err := l.ParseWithDefaults("1 Tamuz", ref); err != nil || !r.Equal(time.Date(2022, time.June, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseWithDefaults(d MMMM, 1 Tamuz) = %s, %v", r, err)
+	}
+}
+
+func TestHebrewRoundTrip(t *testing.T) {
+	cal := datefmt.Hebrew
+	prev := cal.Date(time.Date(1599, time.December, 31, 0, 0, 0, 0, time.UTC))
+	for d := time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2400; d = d.AddDate(0, 0, 1) {
+		cd := cal.Date(d)
+		if cd.Day != prev.Day+1 && (cd.Day != 1 || (cd.Month != prev.Month+1 && cd.Month != prev.Month+2 && cd.Month != 1)) {
+			t.Fatalf("Date(%s) = %v follows %v", d, cd, prev)
+		}
+		prev = cd
+		r, err := cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) = %s, %v", d, r, err)
+		}
+		cd.Month, cd.Day = 0, 0
+		r, err = cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) with year day = %s, %v", d, r, err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package datefmt
+
+import "time"
+
+var (
+	// IslamicCivil is the tabular Islamic (Hijri) calendar with the civil epoch,
+	// 16 July 622 in the Julian calendar. It has 12 months of 29 or 30 days,
+	// and 11 leap years in a 30-year cycle.
+	IslamicCivil Calendar = &islamicCalendar{id: "islamic-civil", epoch: 227015}
+
+	// IslamicTabular is the same as IslamicCivil but with the astronomical epoch,
+	// 15 July 622 in the Julian calendar.
+	IslamicTabular Calendar = &islamicCalendar{id: "islamic-tbla", epoch: 227014}
+)
+
+var (
+	islamicEraNames         = [3]string{"AH", "AH", "AH"}
+	islamicShortMonthNames  = []string{"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II", "Raj.", "Sha.", "Ram.", "Shaw.", "Dhuʻl-Q.", "Dhuʻl-H."}
+	islamicLongMonthNames   = []string{"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II", "Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah"}
+	islamicNarrowMonthNames = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+)
+
+// islamicCalendar is an arithmetic Islamic calendar,
+// see Calendrical Calculations by Reingold and Dershowitz.
+type islamicCalendar struct {
+	id    string
+	epoch int // fixed day number of 1 Muharram 1 AH
+}
+
+func (c *islamicCalendar) String() string {
+	return c.id
+}
+
+func (c *islamicCalendar) Date(t time.Time) CalendarDate {
+	rd := rataDie(t)
+	year := floorDiv(30*(rd-c.epoch)+10646, 10631)
+	priorDays := rd - c.fixed(year, 1, 1)
+	month := floorDiv(11*priorDays+330, 325)
+	day := rd - c.fixed(year, month, 1) + 1
+	return CalendarDate{Year: year, Month: month, Day: day, YearDay: priorDays + 1}
+}
+
+func (c *islamicCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	if d.Era != 0 {
+		return time.Time{}, errEraRange
+	}
+	if d.Month == 0 {
+		if d.YearDay < 1 || d.YearDay > 354+islamicLeapDays(d.Year) {
+			return time.Time{}, errDayRange
+		}
+		return fromRataDie(c.fixed(d.Year, 1, 1)+d.YearDay-1, loc), nil
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return time.Time{}, errMonthRange
+	}
+	if d.Day < 1 || d.Day > islamicDaysIn(d.Month, d.Year) {
+		return time.Time{}, errDayRange
+	}
+	return fromRataDie(c.fixed(d.Year, d.Month, d.Day), loc), nil
+}
+
+func (c *islamicCalendar) Eras() int {
+	return 1
+}
+
+func (c *islamicCalendar) EraName(era int, width NameWidth) string {
+	if era != 0 {
+		return ""
+	}
+	return islamicEraNames[width]
+}
+
+func (c *islamicCalendar) Months() int {
+	return 12
+}
+
+func (c *islamicCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	switch width {
+	case NameShort:
+		return islamicShortMonthNames[d.Month-1]
+	case NameNarrow:
+		return islamicNarrowMonthNames[d.Month-1]
+	default:
+		return islamicLongMonthNames[d.Month-1]
+	}
+}
+
+// fixed returns the fixed day number of the date.
+func (c *islamicCalendar) fixed(year, month, day int) int {
+	return day + 29*(month-1) + floorDiv(6*month-1, 11) + (year-1)*354 + floorDiv(3+11*year, 30) + c.epoch - 1
+}
+
+// islamicLeapDays returns 1 if year is a leap year, otherwise 0.
+func islamicLeapDays(year int) int {
+	if mod(14+11*year, 30) < 11 {
+		return 1
+	}
+	return 0
+}
+
+func islamicDaysIn(month, year int) int {
+	if month == 12 {
+		return 29 + islamicLeapDays(year)
+	}
+	return 30 - (month+1)%2
+}
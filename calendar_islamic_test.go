@@ -0,0 +1,89 @@
+package datefmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func TestIslamicFormat(t *testing.T) {
+	testCases := []struct {
+		cal    datefmt.Calendar
+		layout string
+		in     time.Time
+		out    string
+	}{
+		{cal: datefmt.IslamicCivil, layout: "y-MM-dd G", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "1443-11-20 AH"},
+		{cal: datefmt.IslamicTabular, layout: "y-MM-dd G", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "1443-11-21 AH"},
+		{cal: datefmt.IslamicCivil, layout: "d MMMM y G", in: time.Date(2022, time.July, 30, 0, 0, 0, 0, time.UTC), out: "1 Muharram 1444 AH"},
+		{cal: datefmt.IslamicCivil, layout: "d MMM y D", in: time.Date(2022, time.July, 29, 0, 0, 0, 0, time.UTC), out: "29 Dhuʻl-H. 1443 354"},
+		{cal: datefmt.IslamicCivil, layout: "d MMMM y D", in: time.Date(2021, time.August, 9, 0, 0, 0, 0, time.UTC), out: "30 Dhuʻl-Hijjah 1442 355"},
+		{cal: datefmt.IslamicCivil, layout: "y-MM-dd", in: time.Date(622, time.July, 19, 0, 0, 0, 0, time.UTC), out: "1-01-01"},
+		{cal: datefmt.IslamicCivil, layout: "y-MM-dd", in: time.Date(622, time.July, 18, 0, 0, 0, 0, time.UTC), out: "0-12-29"},
+		{cal: datefmt.IslamicCivil, layout: "y-MM-dd", in: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), out: "1317-08-28"},
+		{cal: datefmt.IslamicCivil, layout: "EEEE, d MMMM y HH:mm", in: time.Date(2022, time.April, 2, 9, 49, 0, 0, time.UTC), out: "Saturday, 29 Shaʻban 1443 09:49"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(c.cal))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s, %s) = %s; want %s", c.cal, c.layout, c.in, r, c.out)
+		}
+	}
+}
+
+func TestIslamicParse(t *testing.T) {
+	testCases := []struct {
+		cal    datefmt.Calendar
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{cal: datefmt.IslamicCivil, layout: "y-MM-dd G", in: "1443-11-20 AH", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.IslamicTabular, layout: "y-MM-dd G", in: "1443-11-21 AH", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.IslamicCivil, layout: "d MMMM y", in: "1 muharram 1444", out: time.Date(2022, time.July, 30, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.IslamicCivil, layout: "d MMM y", in: "30 Dhuʻl-H. 1442", out: time.Date(2021, time.August, 9, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.IslamicCivil, layout: "y D", in: "1443 354", out: time.Date(2022, time.July, 29, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.IslamicCivil, layout: "yyyyMMdd", in: "14431120", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(c.cal))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s, %s) returns error: %v", c.cal, c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s, %s) = %s; want %s", c.cal, c.layout, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"1443-12-30", "1443-13-01", "1443-02-30", "1443-00-10"} {
+		l := datefmt.NewLayout("y-MM-dd", datefmt.WithCalendar(datefmt.IslamicCivil))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+}
+
+func TestIslamicRoundTrip(t *testing.T) {
+	for _, cal := range []datefmt.Calendar{datefmt.IslamicCivil, datefmt.IslamicTabular} {
+		prev := cal.Date(time.Date(1599, time.December, 31, 0, 0, 0, 0, time.UTC))
+		for d := time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2400; d = d.AddDate(0, 0, 1) {
+			cd := cal.Date(d)
+			if cd.Day != prev.Day+1 && (cd.Day != 1 || cd.Month != prev.Month%12+1) {
+				t.Fatalf("%s: Date(%s) = %v follows %v", cal, d, cd, prev)
+			}
+			prev = cd
+			r, err := cal.Time(cd, time.UTC)
+			if err != nil || !r.Equal(d) {
+				t.Fatalf("%s: Time(Date(%s)) = %s, %v", cal, d, r, err)
+			}
+			cd.Month, cd.Day = 0, 0
+			r, err = cal.Time(cd, time.UTC)
+			if err != nil || !r.Equal(d) {
+				t.Fatalf("%s: Time(Date(%s)) with year day = %s, %v", cal, d, r, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package datefmt
+
+import "time"
+
+// Japanese is the Japanese imperial calendar, in which years are counted from
+// the beginning of the eras Meiji, Taisho, Showa, Heisei and Reiwa.
+// Months and days are the same as the Gregorian calendar.
+// Dates before Meiji are in Meiji with years less than 1.
+var Japanese Calendar = japaneseCalendar{}
+
+type japaneseEra struct {
+	year  int
+	month time.Month
+	day   int
+	names [3]string // short, long and narrow names
+}
+
+var japaneseEras = []japaneseEra{
+	{year: 1868, month: time.September, day: 8, names: [3]string{"明治", "明治", "M"}},
+	{year: 1912, month: time.July, day: 30, names: [3]string{"大正", "大正", "T"}},
+	{year: 1926, month: time.December, day: 25, names: [3]string{"昭和", "昭和", "S"}},
+	{year: 1989, month: time.January, day: 8, names: [3]string{"平成", "平成", "H"}},
+	{year: 2019, month: time.May, day: 1, names: [3]string{"令和", "令和", "R"}},
+}
+
+type japaneseCalendar struct{}
+
+func (japaneseCalendar) String() string {
+	return "japanese"
+}
+
+func (japaneseCalendar) Date(t time.Time) CalendarDate {
+	year, month, day := t.Date()
+	era := japaneseEraOf(year, month, day)
+	e := &japaneseEras[era]
+	d := CalendarDate{Era: era, Year: year - e.year + 1, Month: int(month), Day: day, YearDay: t.YearDay()}
+	if d.Year == 1 && era > 0 {
+		// the first year of era begins with the era, except Meiji which covers the earlier dates
+		d.YearDay -= e.start(time.UTC).YearDay() - 1
+	}
+	return d
+}
+
+func (japaneseCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	if d.Era < 0 || d.Era >= len(japaneseEras) {
+		return time.Time{}, errEraRange
+	}
+	var (
+		t    time.Time
+		e    = &japaneseEras[d.Era]
+		year = e.year + d.Year - 1
+	)
+	if d.Month == 0 {
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		if d.Year == 1 && d.Era > 0 {
+			first = e.start(loc)
+		}
+		t = first.AddDate(0, 0, d.YearDay-1)
+		if d.YearDay < 1 || t.Year() != year {
+			return time.Time{}, errDayRange
+		}
+	} else {
+		if d.Month < 1 || d.Month > 12 {
+			return time.Time{}, errMonthRange
+		}
+		if d.Day < 1 || d.Day > daysIn(time.Month(d.Month), year) {
+			return time.Time{}, errDayRange
+		}
+		t = time.Date(year, time.Month(d.Month), d.Day, 0, 0, 0, 0, loc)
+	}
+	if japaneseEraOf(t.Date()) != d.Era {
+		return time.Time{}, errEraRange
+	}
+	return t, nil
+}
+
+func (japaneseCalendar) Eras() int {
+	return len(japaneseEras)
+}
+
+func (japaneseCalendar) EraName(era int, width NameWidth) string {
+	if era < 0 || era >= len(japaneseEras) {
+		return ""
+	}
+	return japaneseEras[era].names[width]
+}
+
+func (japaneseCalendar) Months() int {
+	return 12
+}
+
+func (japaneseCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	return gregorianMonthName(d.Month, width)
+}
+
+func (japaneseCalendar) gregorianMonths() bool {
+	return true
+}
+
+func (e *japaneseEra) start(loc *time.Location) time.Time {
+	return time.Date(e.year, e.month, e.day, 0, 0, 0, 0, loc)
+}
+
+// after reports whether the era begins after the given date.
+func (e *japaneseEra) after(year int, month time.Month, day int) bool {
+	if year != e.year {
+		return e.year > year
+	}
+	if month != e.month {
+		return e.month > month
+	}
+	return e.day > day
+}
+
+func japaneseEraOf(year int, month time.Month, day int) int {
+	era := len(japaneseEras) - 1
+	for era > 0 && japaneseEras[era].after(year, month, day) {
+		era--
+	}
+	return era
+}
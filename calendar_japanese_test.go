@@ -0,0 +1,120 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleWithCalendar() {
+	l := datefmt.NewLayout("GGGGy年M月d日", datefmt.WithCalendar(datefmt.Japanese))
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(l.Format(t))
+	// Output:
+	// 令和4年6月20日
+}
+
+func TestJapaneseFormat(t *testing.T) {
+	testCases := []struct {
+		layout string
+		in     time.Time
+		out    string
+	}{
+		{layout: "GGGGy年M月d日", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "令和4年6月20日"},
+		{layout: "GGGGGyy.MM.dd", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "R04.06.20"},
+		{layout: "Gy年MMM d日 HH:mm", in: time.Date(2022, time.June, 20, 9, 49, 0, 0, time.UTC), out: "令和4年Jun 20日 09:49"},
+		{layout: "Gy-M-d D", in: time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC), out: "平成31-4-30 120"},
+		{layout: "Gy-M-d D", in: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), out: "令和1-5-1 1"},
+		{layout: "Gy-M-d D", in: time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC), out: "令和1-12-31 245"},
+		{layout: "Gy-M-d D", in: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), out: "令和2-1-1 1"},
+		{layout: "Gy-M-d", in: time.Date(1989, time.January, 7, 0, 0, 0, 0, time.UTC), out: "昭和64-1-7"},
+		{layout: "Gy-M-d", in: time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC), out: "平成1-1-8"},
+		{layout: "Gy-M-d", in: time.Date(1926, time.December, 25, 0, 0, 0, 0, time.UTC), out: "昭和1-12-25"},
+		{layout: "Gy-M-d", in: time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC), out: "大正1-7-30"},
+		{layout: "Gy-M-d", in: time.Date(1868, time.September, 8, 0, 0, 0, 0, time.UTC), out: "明治1-9-8"},
+		{layout: "Gy-M-d", in: time.Date(1868, time.January, 1, 0, 0, 0, 0, time.UTC), out: "明治1-1-1"},
+		{layout: "Gy-M-d", in: time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC), out: "明治-67-1-1"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Japanese))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s) = %s; want %s", c.layout, c.in, r, c.out)
+		}
+	}
+}
+
+func TestJapaneseParse(t *testing.T) {
+	testCases := []struct {
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{layout: "GGGGy年M月d日", in: "令和4年6月20日", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "GGGGGyy.MM.dd", in: "R04.06.20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "GGGGGyy.MM.dd", in: "H31.04.30", out: time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{layout: "Gy年MMMM d日", in: "昭和64年January 7日", out: time.Date(1989, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{layout: "Gy D", in: "令和1 1", out: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "Gy D", in: "令和2 1", out: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "y年M月d日", in: "4年6月20日", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Japanese))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s) = %s; want %s", c.layout, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"平成31年5月1日", "令和1年4月30日", "令和4年2月29日", "大化1年1月1日"} {
+		l := datefmt.NewLayout("Gy年M月d日", datefmt.WithCalendar(datefmt.Japanese))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+
+	// the era and year are taken from the Gregorian year of ref across the Heisei/Reiwa boundary
+	defaultsTestCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     string
+		ref    time.Time
+		out    time.Time
+	}{
+		{layout: "M月d日", in: "12月31日", ref: time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC), out: time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{layout: "M月d日", in: "12月31日", ref: time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), out: time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{layout: "M月d日", in: "1月7日", ref: time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC), out: time.Date(2019, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{layout: "M月d日", locale: "ja", in: "5月1日", ref: time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC), out: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "Gy年M月d日", in: "令和1年12月31日", ref: time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), out: time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range defaultsTestCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Japanese), datefmt.WithLocale(c.locale))
+		r, err := l.ParseWithDefaults(c.in, c.ref)
+		if err != nil {
+			t.Errorf("ParseWithDefaults(%s, %s, %s) returns error: %v", c.layout, c.in, c.ref, err)
+		} else if !r.Equal(c.out) {
+			t.Errorf("ParseWithDefaults(%s, %s, %s) = %s; want %s", c.layout, c.in, c.ref, r, c.out)
+		}
+	}
+}
+
+func TestJapaneseRoundTrip(t *testing.T) {
+	cal := datefmt.Japanese
+	for d := time.Date(1850, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+		cd := cal.Date(d)
+		r, err := cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) = %s, %v", d, r, err)
+		}
+		cd.Month, cd.Day = 0, 0
+		r, err = cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) with year day = %s, %v", d, r, err)
+		}
+	}
+}
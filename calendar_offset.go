@@ -0,0 +1,95 @@
+package datefmt
+
+import "time"
+
+var (
+	// Buddhist is the Thai solar calendar, in which years are counted from 543 BC.
+	// Months and days are the same as the Gregorian calendar.
+	Buddhist Calendar = &offsetCalendar{
+		id:    "buddhist",
+		first: -542,
+		eras:  [][3]string{{"BE", "BE", "BE"}},
+	}
+
+	// ROC is the calendar of the Republic of China (Minguo), in which years are
+	// counted from 1912, and backwards for the years before 1912.
+	// Months and days are the same as the Gregorian calendar.
+	ROC Calendar = &offsetCalendar{
+		id:    "roc",
+		first: 1912,
+		eras:  [][3]string{{"民國前", "民國前", "民國前"}, {"民國", "民國", "民國"}},
+	}
+)
+
+// offsetCalendar is a calendar whose years are offset from the Gregorian ones.
+// Its last era begins with the Gregorian year first, and if there is an earlier
+// era, its years are counted backwards from the year before.
+type offsetCalendar struct {
+	id    string
+	first int
+	eras  [][3]string // short, long and narrow names
+}
+
+func (c *offsetCalendar) String() string {
+	return c.id
+}
+
+func (c *offsetCalendar) Date(t time.Time) CalendarDate {
+	year, month, day := t.Date()
+	d := CalendarDate{Era: len(c.eras) - 1, Year: year - c.first + 1, Month: int(month), Day: day, YearDay: t.YearDay()}
+	if d.Year < 1 && d.Era > 0 {
+		d.Era, d.Year = d.Era-1, 1-d.Year
+	}
+	return d
+}
+
+func (c *offsetCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	year := c.first + d.Year - 1
+	switch {
+	case d.Era < 0 || d.Era >= len(c.eras):
+		return time.Time{}, errEraRange
+	case d.Era < len(c.eras)-1:
+		if d.Year < 1 {
+			return time.Time{}, errEraRange
+		}
+		year = c.first - d.Year
+	case d.Era > 0 && d.Year < 1:
+		return time.Time{}, errEraRange
+	}
+	if d.Month == 0 {
+		if d.YearDay < 1 || d.YearDay > daysInYear(year) {
+			return time.Time{}, errDayRange
+		}
+		return time.Date(year, time.January, d.YearDay, 0, 0, 0, 0, loc), nil
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return time.Time{}, errMonthRange
+	}
+	if d.Day < 1 || d.Day > daysIn(time.Month(d.Month), year) {
+		return time.Time{}, errDayRange
+	}
+	return time.Date(year, time.Month(d.Month), d.Day, 0, 0, 0, 0, loc), nil
+}
+
+func (c *offsetCalendar) Eras() int {
+	return len(c.eras)
+}
+
+func (c *offsetCalendar) EraName(era int, width NameWidth) string {
+	if era < 0 || era >= len(c.eras) {
+		return ""
+	}
+	return c.eras[era][width]
+}
+
+func (c *offsetCalendar) Months() int {
+	return 12
+}
+
+func (c *offsetCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	return gregorianMonthName(d.Month, width)
+}
+
+func (c *offsetCalendar) gregorianMonths() bool {
+	return true
+}
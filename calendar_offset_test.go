@@ -0,0 +1,77 @@
+package datefmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func TestOffsetCalendarFormat(t *testing.T) {
+	testCases := []struct {
+		cal    datefmt.Calendar
+		layout string
+		in     time.Time
+		out    string
+	}{
+		{cal: datefmt.Buddhist, layout: "d MMM yyyy G", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "20 Jun 2565 BE"},
+		{cal: datefmt.Buddhist, layout: "y-MM-dd D", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "2565-06-20 171"},
+		{cal: datefmt.Buddhist, layout: "Gy", in: time.Date(-542, time.January, 1, 0, 0, 0, 0, time.UTC), out: "BE1"},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "民國111年6月20日"},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: time.Date(1912, time.January, 1, 0, 0, 0, 0, time.UTC), out: "民國1年1月1日"},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: time.Date(1911, time.December, 31, 0, 0, 0, 0, time.UTC), out: "民國前1年12月31日"},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), out: "民國前12年1月1日"},
+		{cal: datefmt.ROC, layout: "yyy/MM/dd HH:mm", in: time.Date(2022, time.June, 20, 9, 49, 0, 0, time.UTC), out: "111/06/20 09:49"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(c.cal))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s, %s) = %s; want %s", c.cal, c.layout, c.in, r, c.out)
+		}
+	}
+}
+
+func TestOffsetCalendarParse(t *testing.T) {
+	testCases := []struct {
+		cal    datefmt.Calendar
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{cal: datefmt.Buddhist, layout: "d MMM yyyy G", in: "20 Jun 2565 BE", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.Buddhist, layout: "yyyyMMdd", in: "25650620", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.Buddhist, layout: "yyyy D", in: "2563 366", out: time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: "民國111年6月20日", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.ROC, layout: "Gy年M月d日", in: "民國前1年12月31日", out: time.Date(1911, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{cal: datefmt.ROC, layout: "y/MM/dd", in: "111/06/20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(c.cal))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s, %s) returns error: %v", c.cal, c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s, %s) = %s; want %s", c.cal, c.layout, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"民國0年1月1日", "民國前0年1月1日", "民國111年2月29日"} {
+		l := datefmt.NewLayout("Gy年M月d日", datefmt.WithCalendar(datefmt.ROC))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+}
+
+func TestOffsetCalendarRoundTrip(t *testing.T) {
+	for _, cal := range []datefmt.Calendar{datefmt.Buddhist, datefmt.ROC} {
+		for d := time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2100; d = d.AddDate(0, 0, 1) {
+			cd := cal.Date(d)
+			if r, err := cal.Time(cd, time.UTC); err != nil || !r.Equal(d) {
+				t.Fatalf("%s: Time(Date(%s)) = %s, %v", cal, d, r, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package datefmt
+
+import "time"
+
+// Persian is the Persian (Solar Hijri) calendar used in Iran, in which years are
+// counted from 622 AD. The first 6 months have 31 days, the next 5 months have
+// 30 days, and the last month Esfand has 29 days, or 30 days in leap years.
+// Leap years are computed arithmetically in a 33-year cycle like ICU.
+// The month names are transliterated, and in Persian with WithLocale("fa").
+var Persian Calendar = persianCalendar{}
+
+var (
+	persianEraNames   = [3]string{"AP", "AP", "AP"}
+	persianMonthNames = []string{"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"}
+
+	// persianCumulativeDays is the number of days in a year before each month.
+	persianCumulativeDays = []int{0, 31, 62, 93, 124, 155, 186, 216, 246, 276, 306, 336}
+)
+
+// persianEpoch is the fixed day number of 1 Farvardin 1 AP, 18 March 622 in the Julian calendar.
+const persianEpoch = 226895
+
+type persianCalendar struct{}
+
+func (persianCalendar) String() string {
+	return "persian"
+}
+
+func (persianCalendar) Date(t time.Time) CalendarDate {
+	days := rataDie(t) - persianEpoch
+	year := floorDiv(33*days+3, 12053) + 1
+	yearDay := days - persianNewYear(year)
+	month := yearDay / 31
+	if yearDay >= 216 {
+		month = (yearDay - 6) / 30
+	}
+	return CalendarDate{Year: year, Month: month + 1, Day: yearDay - persianCumulativeDays[month] + 1, YearDay: yearDay + 1}
+}
+
+func (persianCalendar) Time(d CalendarDate, loc *time.Location) (time.Time, error) {
+	if d.Era != 0 {
+		return time.Time{}, errEraRange
+	}
+	if d.Month == 0 {
+		if d.YearDay < 1 || d.YearDay > 365+persianLeapDays(d.Year) {
+			return time.Time{}, errDayRange
+		}
+		return fromRataDie(persianEpoch+persianNewYear(d.Year)+d.YearDay-1, loc), nil
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return time.Time{}, errMonthRange
+	}
+	if d.Day < 1 || d.Day > persianDaysIn(d.Month, d.Year) {
+		return time.Time{}, errDayRange
+	}
+	return fromRataDie(persianEpoch+persianNewYear(d.Year)+persianCumulativeDays[d.Month-1]+d.Day-1, loc), nil
+}
+
+func (persianCalendar) Eras() int {
+	return 1
+}
+
+func (persianCalendar) EraName(era int, width NameWidth) string {
+	if era != 0 {
+		return ""
+	}
+	return persianEraNames[width]
+}
+
+func (persianCalendar) Months() int {
+	return 12
+}
+
+func (persianCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	if width == NameNarrow {
+		return persianMonthNames[d.Month-1][:1]
+	}
+	return persianMonthNames[d.Month-1]
+}
+
+// persianNewYear returns the number of days from the epoch to the first day of year.
+func persianNewYear(year int) int {
+	return 365*(year-1) + floorDiv(8*year+21, 33)
+}
+
+// persianLeapDays returns 1 if year is a leap year, otherwise 0.
+func persianLeapDays(year int) int {
+	if mod(25*year+11, 33) < 8 {
+		return 1
+	}
+	return 0
+}
+
+func persianDaysIn(month, year int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	default:
+		return 29 + persianLeapDays(year)
+	}
+}
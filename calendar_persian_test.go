@@ -0,0 +1,86 @@
+package datefmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func TestPersianFormat(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     time.Time
+		out    string
+	}{
+		{layout: "y-MM-dd G", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "1401-03-30 AP"},
+		{layout: "d MMMM y", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "30 Khordad 1401"},
+		{layout: "d MMMM y D", in: time.Date(2022, time.March, 21, 0, 0, 0, 0, time.UTC), out: "1 Farvardin 1401 1"},
+		{layout: "d MMMM y D", in: time.Date(2022, time.March, 20, 0, 0, 0, 0, time.UTC), out: "29 Esfand 1400 365"},
+		{layout: "d MMMM y D", in: time.Date(2021, time.March, 20, 0, 0, 0, 0, time.UTC), out: "30 Esfand 1399 366"},
+		{layout: "d MMM y", in: time.Date(2022, time.September, 23, 0, 0, 0, 0, time.UTC), out: "1 Mehr 1401"},
+		{layout: "y-MM-dd", in: time.Date(622, time.March, 21, 0, 0, 0, 0, time.UTC), out: "1-01-01"},
+		{layout: "d MMMM y GGGG", locale: "fa-IR", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), out: "30 خرداد 1401 هجری شمسی"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Persian), datefmt.WithLocale(c.locale))
+		if r := l.Format(c.in); r != c.out {
+			t.Errorf("Format(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+}
+
+func TestPersianParse(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		in     string
+		out    time.Time
+	}{
+		{layout: "y-MM-dd G", in: "1401-03-30 AP", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy/MM/dd", in: "1401/03/30", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", in: "30 esfand 1399", out: time.Date(2021, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "y D", in: "1401 1", out: time.Date(2022, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		{layout: "d MMMM y", locale: "fa", in: "30 خرداد 1401", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithCalendar(datefmt.Persian), datefmt.WithLocale(c.locale))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s, %s) returns error: %v", c.layout, c.locale, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Parse(%s, %s, %s) = %s; want %s", c.layout, c.locale, c.in, r, c.out)
+		}
+	}
+
+	for _, in := range []string{"1400-12-30", "1401-07-31", "1401-13-01", "1401-00-01"} {
+		l := datefmt.NewLayout("y-MM-dd", datefmt.WithCalendar(datefmt.Persian))
+		if _, err := l.Parse(in); err == nil {
+			t.Errorf("Parse(%s) should return error", in)
+		}
+	}
+}
+
+func TestPersianRoundTrip(t *testing.T) {
+	cal := datefmt.Persian
+	prev := cal.Date(time.Date(1599, time.December, 31, 0, 0, 0, 0, time.UTC))
+	for d := time.Date(1600, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2400; d = d.AddDate(0, 0, 1) {
+		cd := cal.Date(d)
+		if cd.Day != prev.Day+1 && (cd.Day != 1 || cd.Month != prev.Month%12+1) {
+			t.Fatalf("Date(%s) = %v follows %v", d, cd, prev)
+		}
+		prev = cd
+		r, err := cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) = %s, %v", d, r, err)
+		}
+		cd.Month, cd.Day = 0, 0
+		r, err = cal.Time(cd, time.UTC)
+		if err != nil || !r.Equal(d) {
+			t.Fatalf("Time(Date(%s)) with year day = %s, %v", d, r, err)
+		}
+	}
+}
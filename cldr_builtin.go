@@ -0,0 +1,1227 @@
+// Code generated by datefmt-cldrgen; DO NOT EDIT.
+
+package datefmt
+
+// dayPeriodsEN is the built-in English day periods.
+var dayPeriodsEN = &dayPeriodData{
+	rules: []dayPeriodRule{
+		{id: "midnight", from: 0 * 60, before: 0 * 60},
+		{id: "morning1", from: 6 * 60, before: 12 * 60},
+		{id: "afternoon1", from: 12 * 60, before: 18 * 60},
+		{id: "noon", from: 12 * 60, before: 12 * 60},
+		{id: "evening1", from: 18 * 60, before: 21 * 60},
+		{id: "night1", from: 21 * 60, before: 6 * 60},
+	},
+	names: map[string][3]string{
+		"am":         {"AM", "AM", "a"},
+		"pm":         {"PM", "PM", "p"},
+		"midnight":   {"midnight", "midnight", "mi"},
+		"morning1":   {"in the morning", "in the morning", "in the morning"},
+		"afternoon1": {"in the afternoon", "in the afternoon", "in the afternoon"},
+		"noon":       {"noon", "noon", "n"},
+		"evening1":   {"in the evening", "in the evening", "in the evening"},
+		"night1":     {"at night", "at night", "at night"},
+	},
+}
+
+// zoneInfos is the data of the time zones by ID.
+var zoneInfos = map[string]zoneInfo{
+	"Africa/Abidjan":                 {metazone: "GMT", short: "ciabj", region: "CI"},
+	"Africa/Accra":                   {metazone: "GMT", short: "ghacc", region: "GH"},
+	"Africa/Addis_Ababa":             {metazone: "Africa_Eastern", short: "etadd", region: "ET"},
+	"Africa/Algiers":                 {metazone: "Europe_Central", short: "dzalg", region: "DZ"},
+	"Africa/Asmara":                  {metazone: "Africa_Eastern", short: "erasm", region: "ER"},
+	"Africa/Bamako":                  {metazone: "GMT", short: "mlbko", region: "ML"},
+	"Africa/Bangui":                  {metazone: "Africa_Western", short: "cfbgf", region: "CF"},
+	"Africa/Banjul":                  {metazone: "GMT", short: "gmbjl", region: "GM"},
+	"Africa/Bissau":                  {metazone: "GMT", short: "gwoxb", region: "GW"},
+	"Africa/Blantyre":                {metazone: "Africa_Central", short: "mwblz", region: "MW"},
+	"Africa/Brazzaville":             {metazone: "Africa_Western", short: "cgbzv", region: "CG"},
+	"Africa/Bujumbura":               {metazone: "Africa_Central", short: "bibjm", region: "BI"},
+	"Africa/Cairo":                   {metazone: "Europe_Eastern", short: "egcai", region: "EG"},
+	"Africa/Casablanca":              {short: "macas", region: "MA"},
+	"Africa/Ceuta":                   {metazone: "Europe_Central", short: "esceu"},
+	"Africa/Conakry":                 {metazone: "GMT", short: "gncky", region: "GN"},
+	"Africa/Dakar":                   {metazone: "GMT", short: "sndkr", region: "SN"},
+	"Africa/Dar_es_Salaam":           {metazone: "Africa_Eastern", short: "tzdar", region: "TZ"},
+	"Africa/Djibouti":                {metazone: "Africa_Eastern", short: "djjib", region: "DJ"},
+	"Africa/Douala":                  {metazone: "Africa_Western", short: "cmdla", region: "CM"},
+	"Africa/El_Aaiun":                {short: "eheai", region: "EH"},
+	"Africa/Freetown":                {metazone: "GMT", short: "slfna", region: "SL"},
+	"Africa/Gaborone":                {metazone: "Africa_Central", short: "bwgbe", region: "BW"},
+	"Africa/Harare":                  {metazone: "Africa_Central", short: "zwhre", region: "ZW"},
+	"Africa/Johannesburg":            {metazone: "Africa_Southern", short: "zajnb", region: "ZA"},
+	"Africa/Juba":                    {metazone: "Africa_Central", short: "ssjub", region: "SS"},
+	"Africa/Kampala":                 {metazone: "Africa_Eastern", short: "ugkla", region: "UG"},
+	"Africa/Khartoum":                {metazone: "Africa_Central", short: "sdkrt", region: "SD"},
+	"Africa/Kigali":                  {metazone: "Africa_Central", short: "rwkgl", region: "RW"},
+	"Africa/Kinshasa":                {metazone: "Africa_Western", short: "cdfih"},
+	"Africa/Lagos":                   {metazone: "Africa_Western", short: "nglos", region: "NG"},
+	"Africa/Libreville":              {metazone: "Africa_Western", short: "galbv", region: "GA"},
+	"Africa/Lome":                    {metazone: "GMT", short: "tglfw", region: "TG"},
+	"Africa/Luanda":                  {metazone: "Africa_Western", short: "aolad", region: "AO"},
+	"Africa/Lubumbashi":              {metazone: "Africa_Central", short: "cdfbm"},
+	"Africa/Lusaka":                  {metazone: "Africa_Central", short: "zmlun", region: "ZM"},
+	"Africa/Malabo":                  {metazone: "Africa_Western", short: "gqssg", region: "GQ"},
+	"Africa/Maputo":                  {metazone: "Africa_Central", short: "mzmpm", region: "MZ"},
+	"Africa/Maseru":                  {metazone: "Africa_Southern", short: "lsmsu", region: "LS"},
+	"Africa/Mbabane":                 {metazone: "Africa_Southern", short: "szqmn", region: "SZ"},
+	"Africa/Mogadishu":               {metazone: "Africa_Eastern", short: "somgq", region: "SO"},
+	"Africa/Monrovia":                {metazone: "GMT", short: "lrmlw", region: "LR"},
+	"Africa/Nairobi":                 {metazone: "Africa_Eastern", short: "kenbo", region: "KE"},
+	"Africa/Ndjamena":                {metazone: "Africa_Western", short: "tdndj", region: "TD"},
+	"Africa/Niamey":                  {metazone: "Africa_Western", short: "nenim", region: "NE"},
+	"Africa/Nouakchott":              {metazone: "GMT", short: "mrnkc", region: "MR"},
+	"Africa/Ouagadougou":             {metazone: "GMT", short: "bfoua", region: "BF"},
+	"Africa/Porto-Novo":              {metazone: "Africa_Western", short: "bjptn", region: "BJ"},
+	"Africa/Sao_Tome":                {metazone: "GMT", short: "sttms", region: "ST"},
+	"Africa/Tripoli":                 {metazone: "Europe_Eastern", short: "lytip", region: "LY"},
+	"Africa/Tunis":                   {metazone: "Europe_Central", short: "tntun", region: "TN"},
+	"Africa/Windhoek":                {metazone: "Africa_Central", short: "nawdh", region: "NA"},
+	"America/Adak":                   {metazone: "Hawaii_Aleutian", short: "usadk"},
+	"America/Anchorage":              {metazone: "Alaska", short: "usanc"},
+	"America/Anguilla":               {metazone: "Atlantic", short: "aiaxa", region: "AI"},
+	"America/Antigua":                {metazone: "Atlantic", short: "aganu", region: "AG"},
+	"America/Araguaina":              {metazone: "Brasilia", short: "braux"},
+	"America/Argentina/Buenos_Aires": {metazone: "Argentina", short: "arbue"},
+	"America/Argentina/Catamarca":    {metazone: "Argentina", short: "arctc"},
+	"America/Argentina/Cordoba":      {metazone: "Argentina", short: "arcor"},
+	"America/Argentina/Jujuy":        {metazone: "Argentina", short: "arjuj"},
+	"America/Argentina/La_Rioja":     {metazone: "Argentina", short: "arirj"},
+	"America/Argentina/Mendoza":      {metazone: "Argentina", short: "armdz"},
+	"America/Argentina/Rio_Gallegos": {metazone: "Argentina", short: "arrgl"},
+	"America/Argentina/Salta":        {metazone: "Argentina", short: "arsla"},
+	"America/Argentina/San_Juan":     {metazone: "Argentina", short: "aruaq"},
+	"America/Argentina/San_Luis":     {metazone: "Argentina", short: "arluq"},
+	"America/Argentina/Tucuman":      {metazone: "Argentina", short: "artuc"},
+	"America/Argentina/Ushuaia":      {metazone: "Argentina", short: "arush"},
+	"America/Aruba":                  {metazone: "Atlantic", short: "awaua", region: "AW"},
+	"America/Asuncion":               {metazone: "Paraguay", short: "pyasu", region: "PY"},
+	"America/Atikokan":               {metazone: "America_Eastern", short: "cayzs"},
+	"America/Bahia":                  {metazone: "Brasilia", short: "brssa"},
+	"America/Bahia_Banderas":         {metazone: "America_Central", short: "mxpvr"},
+	"America/Barbados":               {metazone: "Atlantic", short: "bbbgi", region: "BB"},
+	"America/Belem":                  {metazone: "Brasilia", short: "brbel"},
+	"America/Belize":                 {metazone: "America_Central", short: "bzbze", region: "BZ"},
+	"America/Blanc-Sablon":           {metazone: "Atlantic", short: "caybx"},
+	"America/Boa_Vista":              {metazone: "Amazon", short: "brbvb"},
+	"America/Bogota":                 {metazone: "Colombia", short: "cobog", region: "CO"},
+	"America/Boise":                  {metazone: "America_Mountain", short: "usboi"},
+	"America/Cambridge_Bay":          {metazone: "America_Mountain", short: "caycb"},
+	"America/Campo_Grande":           {metazone: "Amazon", short: "brcgr"},
+	"America/Cancun":                 {metazone: "America_Eastern", short: "mxcun"},
+	"America/Caracas":                {metazone: "Venezuela", short: "veccs", region: "VE"},
+	"America/Cayenne":                {metazone: "French_Guiana", short: "gfcay", region: "GF"},
+	"America/Cayman":                 {metazone: "America_Eastern", short: "kygec", region: "KY"},
+	"America/Chicago":                {metazone: "America_Central", short: "uschi"},
+	"America/Chihuahua":              {metazone: "America_Central", short: "mxchi"},
+	"America/Ciudad_Juarez":          {metazone: "America_Mountain", short: "mxcjs"},
+	"America/Costa_Rica":             {metazone: "America_Central", short: "crsjo", region: "CR"},
+	"America/Coyhaique":              {short: "clcxq"},
+	"America/Creston":                {metazone: "America_Mountain", short: "cacfq"},
+	"America/Cuiaba":                 {metazone: "Amazon", short: "brcgb"},
+	"America/Curacao":                {metazone: "Atlantic", short: "ancur", region: "AN"},
+	"America/Danmarkshavn":           {metazone: "GMT", short: "gldkshvn"},
+	"America/Dawson":                 {metazone: "Yukon", short: "cayda"},
+	"America/Dawson_Creek":           {metazone: "America_Mountain", short: "caydq"},
+	"America/Denver":                 {metazone: "America_Mountain", short: "usden"},
+	"America/Detroit":                {metazone: "America_Eastern", short: "usdet"},
+	"America/Dominica":               {metazone: "Atlantic", short: "dmdom", region: "DM"},
+	"America/Edmonton":               {metazone: "America_Mountain", short: "caedm"},
+	"America/Eirunepe":               {metazone: "Acre", short: "brern"},
+	"America/El_Salvador":            {metazone: "America_Central", short: "svsal", region: "SV"},
+	"America/Fort_Nelson":            {metazone: "America_Mountain", short: "cafne"},
+	"America/Fortaleza":              {metazone: "Brasilia", short: "brfor"},
+	"America/Glace_Bay":              {metazone: "Atlantic", short: "caglb"},
+	"America/Goose_Bay":              {metazone: "Atlantic", short: "cagoo"},
+	"America/Grand_Turk":             {metazone: "America_Eastern", short: "tcgdt", region: "TC"},
+	"America/Grenada":                {metazone: "Atlantic", short: "gdgnd", region: "GD"},
+	"America/Guadeloupe":             {metazone: "Atlantic", short: "gpbbr"},
+	"America/Guatemala":              {metazone: "America_Central", short: "gtgua", region: "GT"},
+	"America/Guayaquil":              {metazone: "Ecuador", short: "ecgye", region: "EC"},
+	"America/Guyana":                 {metazone: "Guyana", short: "gygeo", region: "GY"},
+	"America/Halifax":                {metazone: "Atlantic", short: "cahal"},
+	"America/Havana":                 {metazone: "Cuba", short: "cuhav", region: "CU"},
+	"America/Hermosillo":             {metazone: "Mexico_Pacific", short: "mxhmo"},
+	"America/Indiana/Indianapolis":   {metazone: "America_Eastern", short: "usind"},
+	"America/Indiana/Knox":           {metazone: "America_Central", short: "usknx"},
+	"America/Indiana/Marengo":        {metazone: "America_Eastern", short: "usaeg"},
+	"America/Indiana/Petersburg":     {metazone: "America_Eastern", short: "uswsq"},
+	"America/Indiana/Tell_City":      {metazone: "America_Central", short: "ustel"},
+	"America/Indiana/Vevay":          {metazone: "America_Eastern", short: "usinvev"},
+	"America/Indiana/Vincennes":      {metazone: "America_Eastern", short: "usoea"},
+	"America/Indiana/Winamac":        {metazone: "America_Eastern", short: "uswlz"},
+	"America/Inuvik":                 {metazone: "America_Mountain", short: "cayev"},
+	"America/Iqaluit":                {metazone: "America_Eastern", short: "caiql"},
+	"America/Jamaica":                {metazone: "America_Eastern", short: "jmkin", region: "JM"},
+	"America/Juneau":                 {metazone: "Alaska", short: "usjnu"},
+	"America/Kentucky/Louisville":    {metazone: "America_Eastern", short: "uslui"},
+	"America/Kentucky/Monticello":    {metazone: "America_Eastern", short: "usmoc"},
+	"America/Kralendijk":             {metazone: "Atlantic", short: "bqkra", region: "BQ"},
+	"America/La_Paz":                 {metazone: "Bolivia", short: "bolpb", region: "BO"},
+	"America/Lima":                   {metazone: "Peru", short: "pelim", region: "PE"},
+	"America/Los_Angeles":            {metazone: "America_Pacific", short: "uslax"},
+	"America/Lower_Princes":          {metazone: "Atlantic", short: "sxphi", region: "SX"},
+	"America/Maceio":                 {metazone: "Brasilia", short: "brmcz"},
+	"America/Managua":                {metazone: "America_Central", short: "nimga", region: "NI"},
+	"America/Manaus":                 {metazone: "Amazon", short: "brmao"},
+	"America/Marigot":                {metazone: "Atlantic", short: "gpmsb"},
+	"America/Martinique":             {metazone: "Atlantic", short: "mqfdf", region: "MQ"},
+	"America/Matamoros":              {metazone: "America_Central", short: "mxmam"},
+	"America/Mazatlan":               {metazone: "Mexico_Pacific", short: "mxmzt"},
+	"America/Menominee":              {metazone: "America_Central", short: "usmnm"},
+	"America/Merida":                 {metazone: "America_Central", short: "mxmid"},
+	"America/Metlakatla":             {metazone: "Alaska", short: "usmtm"},
+	"America/Mexico_City":            {metazone: "America_Central", short: "mxmex"},
+	"America/Miquelon":               {metazone: "Pierre_Miquelon", short: "pmmqc", region: "PM"},
+	"America/Moncton":                {metazone: "Atlantic", short: "camon"},
+	"America/Monterrey":              {metazone: "America_Central", short: "mxmty"},
+	"America/Montevideo":             {metazone: "Uruguay", short: "uymvd", region: "UY"},
+	"America/Montserrat":             {metazone: "Atlantic", short: "msmni", region: "MS"},
+	"America/Nassau":                 {metazone: "America_Eastern", short: "bsnas", region: "BS"},
+	"America/New_York":               {metazone: "America_Eastern", short: "usnyc"},
+	"America/Nome":                   {metazone: "Alaska", short: "usome"},
+	"America/Noronha":                {metazone: "Noronha", short: "brfen"},
+	"America/North_Dakota/Beulah":    {metazone: "America_Central", short: "usxul"},
+	"America/North_Dakota/Center":    {metazone: "America_Central", short: "usndcnt"},
+	"America/North_Dakota/New_Salem": {metazone: "America_Central", short: "usndnsl"},
+	"America/Nuuk":                   {metazone: "Greenland", short: "glgoh"},
+	"America/Ojinaga":                {metazone: "America_Central", short: "mxoji"},
+	"America/Panama":                 {metazone: "America_Eastern", short: "papty", region: "PA"},
+	"America/Paramaribo":             {metazone: "Suriname", short: "srpbm", region: "SR"},
+	"America/Phoenix":                {metazone: "America_Mountain", short: "usphx"},
+	"America/Port-au-Prince":         {metazone: "America_Eastern", short: "htpap", region: "HT"},
+	"America/Port_of_Spain":          {metazone: "Atlantic", short: "ttpos", region: "TT"},
+	"America/Porto_Velho":            {metazone: "Amazon", short: "brpvh"},
+	"America/Puerto_Rico":            {metazone: "Atlantic", short: "prsju", region: "PR"},
+	"America/Punta_Arenas":           {short: "clpuq"},
+	"America/Rankin_Inlet":           {metazone: "America_Central", short: "cayek"},
+	"America/Recife":                 {metazone: "Brasilia", short: "brrec"},
+	"America/Regina":                 {metazone: "America_Central", short: "careg"},
+	"America/Resolute":               {metazone: "America_Central", short: "careb"},
+	"America/Rio_Branco":             {metazone: "Acre", short: "brrbr"},
+	"America/Santarem":               {metazone: "Brasilia", short: "brstm"},
+	"America/Santiago":               {metazone: "Chile", short: "clscl", region: "CL"},
+	"America/Santo_Domingo":          {metazone: "Atlantic", short: "dosdq", region: "DO"},
+	"America/Sao_Paulo":              {metazone: "Brasilia", short: "brsao"},
+	"America/Scoresbysund":           {metazone: "Greenland", short: "globy"},
+	"America/Sitka":                  {metazone: "Alaska", short: "ussit"},
+	"America/St_Barthelemy":          {metazone: "Atlantic", short: "gpsbh"},
+	"America/St_Johns":               {metazone: "Newfoundland", short: "casjf"},
+	"America/St_Kitts":               {metazone: "Atlantic", short: "knbas", region: "KN"},
+	"America/St_Lucia":               {metazone: "Atlantic", short: "lccas", region: "LC"},
+	"America/St_Thomas":              {metazone: "Atlantic", short: "vistt", region: "VI"},
+	"America/St_Vincent":             {metazone: "Atlantic", short: "vcsvd", region: "VC"},
+	"America/Swift_Current":          {metazone: "America_Central", short: "cayyn"},
+	"America/Tegucigalpa":            {metazone: "America_Central", short: "hntgu", region: "HN"},
+	"America/Thule":                  {metazone: "Atlantic", short: "glthu"},
+	"America/Tijuana":                {metazone: "America_Pacific", short: "mxtij"},
+	"America/Toronto":                {metazone: "America_Eastern", short: "cator"},
+	"America/Tortola":                {metazone: "Atlantic", short: "vgtov", region: "VG"},
+	"America/Vancouver":              {metazone: "America_Pacific", short: "cavan"},
+	"America/Whitehorse":             {metazone: "Yukon", short: "cayxy"},
+	"America/Winnipeg":               {metazone: "America_Central", short: "cawnp"},
+	"America/Yakutat":                {metazone: "Alaska", short: "usyak"},
+	"Antarctica/Casey":               {metazone: "Australia_Western", short: "aqcas"},
+	"Antarctica/Davis":               {metazone: "Davis", short: "aqdav"},
+	"Antarctica/DumontDUrville":      {metazone: "DumontDUrville", short: "aqddu"},
+	"Antarctica/Macquarie":           {metazone: "Australia_Eastern", short: "aumqi"},
+	"Antarctica/Mawson":              {metazone: "Mawson", short: "aqmaw"},
+	"Antarctica/McMurdo":             {metazone: "New_Zealand", short: "aqmcm"},
+	"Antarctica/Palmer":              {short: "aqplm"},
+	"Antarctica/Rothera":             {metazone: "Rothera", short: "aqrot"},
+	"Antarctica/Syowa":               {metazone: "Syowa", short: "aqsyw"},
+	"Antarctica/Troll":               {metazone: "GMT", short: "aqtrl"},
+	"Antarctica/Vostok":              {metazone: "Vostok", short: "aqvos"},
+	"Arctic/Longyearbyen":            {metazone: "Europe_Central", short: "sjlyr", region: "SJ"},
+	"Asia/Aden":                      {metazone: "Arabian", short: "yeade", region: "YE"},
+	"Asia/Almaty":                    {metazone: "Kazakhstan", short: "kzala"},
+	"Asia/Amman":                     {short: "joamm", region: "JO"},
+	"Asia/Anadyr":                    {metazone: "Anadyr", short: "rudyr"},
+	"Asia/Aqtau":                     {metazone: "Kazakhstan", short: "kzaau"},
+	"Asia/Aqtobe":                    {metazone: "Kazakhstan", short: "kzakx"},
+	"Asia/Ashgabat":                  {metazone: "Turkmenistan", short: "tmasb", region: "TM"},
+	"Asia/Atyrau":                    {metazone: "Kazakhstan", short: "kzguw"},
+	"Asia/Baghdad":                   {metazone: "Arabian", short: "iqbgw", region: "IQ"},
+	"Asia/Bahrain":                   {metazone: "Arabian", short: "bhbah", region: "BH"},
+	"Asia/Baku":                      {metazone: "Azerbaijan", short: "azbak", region: "AZ"},
+	"Asia/Bangkok":                   {metazone: "Indochina", short: "thbkk", region: "TH"},
+	"Asia/Barnaul":                   {short: "rubax"},
+	"Asia/Beirut":                    {metazone: "Europe_Eastern", short: "lbbey", region: "LB"},
+	"Asia/Bishkek":                   {metazone: "Kyrgystan", short: "kgfru", region: "KG"},
+	"Asia/Brunei":                    {metazone: "Brunei", short: "bnbwn", region: "BN"},
+	"Asia/Chita":                     {metazone: "Yakutsk", short: "ruchita"},
+	"Asia/Colombo":                   {metazone: "India", short: "lkcmb", region: "LK"},
+	"Asia/Damascus":                  {short: "sydam", region: "SY"},
+	"Asia/Dhaka":                     {metazone: "Bangladesh", short: "bddac", region: "BD"},
+	"Asia/Dili":                      {metazone: "East_Timor", short: "tldil", region: "TL"},
+	"Asia/Dubai":                     {metazone: "Gulf", short: "aedxb", region: "AE"},
+	"Asia/Dushanbe":                  {metazone: "Tajikistan", short: "tjdyu", region: "TJ"},
+	"Asia/Famagusta":                 {short: "cyfmg"},
+	"Asia/Gaza":                      {metazone: "Europe_Eastern", short: "gazastrp"},
+	"Asia/Hebron":                    {metazone: "Europe_Eastern", short: "hebron"},
+	"Asia/Ho_Chi_Minh":               {metazone: "Indochina", short: "vnsgn", region: "VN"},
+	"Asia/Hong_Kong":                 {metazone: "Hong_Kong", short: "hkhkg", region: "HK"},
+	"Asia/Hovd":                      {metazone: "Hovd", short: "mnhvd"},
+	"Asia/Irkutsk":                   {metazone: "Irkutsk", short: "ruikt"},
+	"Asia/Jakarta":                   {metazone: "Indonesia_Western", short: "idjkt"},
+	"Asia/Jayapura":                  {metazone: "Indonesia_Eastern", short: "iddjj"},
+	"Asia/Jerusalem":                 {metazone: "Israel", short: "jeruslm"},
+	"Asia/Kabul":                     {metazone: "Afghanistan", short: "afkbl", region: "AF"},
+	"Asia/Kamchatka":                 {metazone: "Kamchatka", short: "rupkc"},
+	"Asia/Karachi":                   {metazone: "Pakistan", short: "pkkhi", region: "PK"},
+	"Asia/Kathmandu":                 {metazone: "Nepal", short: "npktm", region: "NP"},
+	"Asia/Khandyga":                  {metazone: "Yakutsk", short: "rukhndg"},
+	"Asia/Kolkata":                   {metazone: "India", short: "inccu", region: "IN"},
+	"Asia/Krasnoyarsk":               {metazone: "Krasnoyarsk", short: "rukra"},
+	"Asia/Kuala_Lumpur":              {metazone: "Malaysia", short: "mykul", region: "MY"},
+	"Asia/Kuching":                   {metazone: "Malaysia", short: "mykch"},
+	"Asia/Kuwait":                    {metazone: "Arabian", short: "kwkwi", region: "KW"},
+	"Asia/Macau":                     {metazone: "China", short: "momfm", region: "MO"},
+	"Asia/Magadan":                   {metazone: "Magadan", short: "rugdx"},
+	"Asia/Makassar":                  {metazone: "Indonesia_Central", short: "idmak"},
+	"Asia/Manila":                    {metazone: "Philippines", short: "phmnl", region: "PH"},
+	"Asia/Muscat":                    {metazone: "Gulf", short: "ommct", region: "OM"},
+	"Asia/Nicosia":                   {metazone: "Europe_Eastern", short: "cynic"},
+	"Asia/Novokuznetsk":              {metazone: "Krasnoyarsk", short: "runoz"},
+	"Asia/Novosibirsk":               {metazone: "Novosibirsk", short: "ruovb"},
+	"Asia/Omsk":                      {metazone: "Omsk", short: "ruoms"},
+	"Asia/Oral":                      {metazone: "Kazakhstan", short: "kzura"},
+	"Asia/Phnom_Penh":                {metazone: "Indochina", short: "khpnh", region: "KH"},
+	"Asia/Pontianak":                 {metazone: "Indonesia_Western", short: "idpnk"},
+	"Asia/Pyongyang":                 {metazone: "Korea", short: "kpfnj", region: "KP"},
+	"Asia/Qatar":                     {metazone: "Arabian", short: "qadoh", region: "QA"},
+	"Asia/Qostanay":                  {metazone: "Kazakhstan", short: "kzksn"},
+	"Asia/Qyzylorda":                 {metazone: "Kazakhstan", short: "kzkzo"},
+	"Asia/Riyadh":                    {metazone: "Arabian", short: "saruh", region: "SA"},
+	"Asia/Sakhalin":                  {metazone: "Sakhalin", short: "ruuus"},
+	"Asia/Samarkand":                 {metazone: "Uzbekistan", short: "uzskd"},
+	"Asia/Seoul":                     {metazone: "Korea", short: "krsel", region: "KR"},
+	"Asia/Shanghai":                  {metazone: "China", short: "cnsha", region: "CN"},
+	"Asia/Singapore":                 {metazone: "Singapore", short: "sgsin", region: "SG"},
+	"Asia/Srednekolymsk":             {short: "rusred"},
+	"Asia/Taipei":                    {metazone: "Taipei", short: "twtpe", region: "TW"},
+	"Asia/Tashkent":                  {metazone: "Uzbekistan", short: "uztas", region: "UZ"},
+	"Asia/Tbilisi":                   {metazone: "Georgia", short: "getbs", region: "GE"},
+	"Asia/Tehran":                    {metazone: "Iran", short: "irthr", region: "IR"},
+	"Asia/Thimphu":                   {metazone: "Bhutan", short: "btthi", region: "BT"},
+	"Asia/Tokyo":                     {metazone: "Japan", short: "jptyo", region: "JP"},
+	"Asia/Tomsk":                     {short: "rutof"},
+	"Asia/Ulaanbaatar":               {metazone: "Mongolia", short: "mnuln"},
+	"Asia/Urumqi":                    {metazone: "Urumqi", short: "cnurc"},
+	"Asia/Ust-Nera":                  {metazone: "Vladivostok", short: "ruunera"},
+	"Asia/Vientiane":                 {metazone: "Indochina", short: "lavte", region: "LA"},
+	"Asia/Vladivostok":               {metazone: "Vladivostok", short: "ruvvo"},
+	"Asia/Yakutsk":                   {metazone: "Yakutsk", short: "ruyks"},
+	"Asia/Yangon":                    {metazone: "Myanmar", short: "mmrgn", region: "MM"},
+	"Asia/Yekaterinburg":             {metazone: "Yekaterinburg", short: "ruyek"},
+	"Asia/Yerevan":                   {metazone: "Armenia", short: "amevn", region: "AM"},
+	"Atlantic/Azores":                {metazone: "Azores", short: "ptpdl"},
+	"Atlantic/Bermuda":               {metazone: "Atlantic", short: "bmbda", region: "BM"},
+	"Atlantic/Canary":                {metazone: "Europe_Western", short: "eslpa"},
+	"Atlantic/Cape_Verde":            {metazone: "Cape_Verde", short: "cvrai", region: "CV"},
+	"Atlantic/Faroe":                 {metazone: "Europe_Western", short: "fotho", region: "FO"},
+	"Atlantic/Madeira":               {metazone: "Europe_Western", short: "ptfnc"},
+	"Atlantic/Reykjavik":             {metazone: "GMT", short: "isrey", region: "IS"},
+	"Atlantic/South_Georgia":         {metazone: "South_Georgia", short: "gsgrv", region: "GS"},
+	"Atlantic/St_Helena":             {metazone: "GMT", short: "shshn", region: "SH"},
+	"Atlantic/Stanley":               {metazone: "Falkland", short: "fkpsy", region: "FK"},
+	"Australia/Adelaide":             {metazone: "Australia_Central", short: "auadl"},
+	"Australia/Brisbane":             {metazone: "Australia_Eastern", short: "aubne"},
+	"Australia/Broken_Hill":          {metazone: "Australia_Central", short: "aubhq"},
+	"Australia/Darwin":               {metazone: "Australia_Central", short: "audrw"},
+	"Australia/Eucla":                {metazone: "Australia_CentralWestern", short: "aueuc"},
+	"Australia/Hobart":               {metazone: "Australia_Eastern", short: "auhba"},
+	"Australia/Lindeman":             {metazone: "Australia_Eastern", short: "auldc"},
+	"Australia/Lord_Howe":            {metazone: "Lord_Howe", short: "auldh"},
+	"Australia/Melbourne":            {metazone: "Australia_Eastern", short: "aumel"},
+	"Australia/Perth":                {metazone: "Australia_Western", short: "auper"},
+	"Australia/Sydney":               {metazone: "Australia_Eastern", short: "ausyd"},
+	"Etc/GMT":                        {metazone: "GMT", short: "gmt"},
+	"Etc/GMT+1":                      {short: "utcw01"},
+	"Etc/GMT+10":                     {short: "utcw10"},
+	"Etc/GMT+11":                     {short: "utcw11"},
+	"Etc/GMT+12":                     {short: "utcw12"},
+	"Etc/GMT+2":                      {short: "utcw02"},
+	"Etc/GMT+3":                      {short: "utcw03"},
+	"Etc/GMT+4":                      {short: "utcw04"},
+	"Etc/GMT+5":                      {short: "utcw05"},
+	"Etc/GMT+6":                      {short: "utcw06"},
+	"Etc/GMT+7":                      {short: "utcw07"},
+	"Etc/GMT+8":                      {short: "utcw08"},
+	"Etc/GMT+9":                      {short: "utcw09"},
+	"Etc/GMT-1":                      {short: "utce01"},
+	"Etc/GMT-10":                     {short: "utce10"},
+	"Etc/GMT-11":                     {short: "utce11"},
+	"Etc/GMT-12":                     {short: "utce12"},
+	"Etc/GMT-13":                     {short: "utce13"},
+	"Etc/GMT-14":                     {short: "utce14"},
+	"Etc/GMT-2":                      {short: "utce02"},
+	"Etc/GMT-3":                      {short: "utce03"},
+	"Etc/GMT-4":                      {short: "utce04"},
+	"Etc/GMT-5":                      {short: "utce05"},
+	"Etc/GMT-6":                      {short: "utce06"},
+	"Etc/GMT-7":                      {short: "utce07"},
+	"Etc/GMT-8":                      {short: "utce08"},
+	"Etc/GMT-9":                      {short: "utce09"},
+	"Etc/UTC":                        {short: "utc"},
+	"Etc/Unknown":                    {short: "unk"},
+	"Europe/Amsterdam":               {metazone: "Europe_Central", short: "nlams", region: "NL"},
+	"Europe/Andorra":                 {metazone: "Europe_Central", short: "adalv", region: "AD"},
+	"Europe/Astrakhan":               {short: "ruasf"},
+	"Europe/Athens":                  {metazone: "Europe_Eastern", short: "grath", region: "GR"},
+	"Europe/Belgrade":                {metazone: "Europe_Central", short: "rsbeg", region: "RS"},
+	"Europe/Berlin":                  {metazone: "Europe_Central", short: "deber", region: "DE"},
+	"Europe/Bratislava":              {metazone: "Europe_Central", short: "skbts", region: "SK"},
+	"Europe/Brussels":                {metazone: "Europe_Central", short: "bebru", region: "BE"},
+	"Europe/Bucharest":               {metazone: "Europe_Eastern", short: "robuh", region: "RO"},
+	"Europe/Budapest":                {metazone: "Europe_Central", short: "hubud", region: "HU"},
+	"Europe/Busingen":                {metazone: "Europe_Central", short: "debsngn"},
+	"Europe/Chisinau":                {metazone: "Europe_Eastern", short: "mdkiv", region: "MD"},
+	"Europe/Copenhagen":              {metazone: "Europe_Central", short: "dkcph", region: "DK"},
+	"Europe/Dublin":                  {metazone: "GMT", short: "iedub", region: "IE"},
+	"Europe/Gibraltar":               {metazone: "Europe_Central", short: "gigib", region: "GI"},
+	"Europe/Guernsey":                {metazone: "GMT", short: "gggci", region: "GG"},
+	"Europe/Helsinki":                {metazone: "Europe_Eastern", short: "fihel"},
+	"Europe/Isle_of_Man":             {metazone: "GMT", short: "imdgs", region: "IM"},
+	"Europe/Istanbul":                {metazone: "Turkey", short: "trist", region: "TR"},
+	"Europe/Jersey":                  {metazone: "GMT", short: "jesth", region: "JE"},
+	"Europe/Kaliningrad":             {metazone: "Europe_Eastern", short: "rukgd"},
+	"Europe/Kirov":                   {short: "rukvx"},
+	"Europe/Kyiv":                    {metazone: "Europe_Eastern", short: "uaiev", region: "UA"},
+	"Europe/Lisbon":                  {metazone: "Europe_Western", short: "ptlis", region: "PT"},
+	"Europe/Ljubljana":               {metazone: "Europe_Central", short: "silju", region: "SI"},
+	"Europe/London":                  {metazone: "GMT", short: "gblon", region: "GB"},
+	"Europe/Luxembourg":              {metazone: "Europe_Central", short: "lulux", region: "LU"},
+	"Europe/Madrid":                  {metazone: "Europe_Central", short: "esmad", region: "ES"},
+	"Europe/Malta":                   {metazone: "Europe_Central", short: "mtmla", region: "MT"},
+	"Europe/Mariehamn":               {metazone: "Europe_Eastern", short: "fimhq"},
+	"Europe/Minsk":                   {metazone: "Moscow", short: "bymsq", region: "BY"},
+	"Europe/Monaco":                  {metazone: "Europe_Central", short: "mcmon", region: "MC"},
+	"Europe/Moscow":                  {metazone: "Moscow", short: "rumow"},
+	"Europe/Oslo":                    {metazone: "Europe_Central", short: "noosl", region: "NO"},
+	"Europe/Paris":                   {metazone: "Europe_Central", short: "frpar", region: "FR"},
+	"Europe/Podgorica":               {metazone: "Europe_Central", short: "metgd", region: "ME"},
+	"Europe/Prague":                  {metazone: "Europe_Central", short: "czprg", region: "CZ"},
+	"Europe/Riga":                    {metazone: "Europe_Eastern", short: "lvrix", region: "LV"},
+	"Europe/Rome":                    {metazone: "Europe_Central", short: "itrom", region: "IT"},
+	"Europe/Samara":                  {metazone: "Samara", short: "rukuf"},
+	"Europe/San_Marino":              {metazone: "Europe_Central", short: "smsai", region: "SM"},
+	"Europe/Sarajevo":                {metazone: "Europe_Central", short: "basjj", region: "BA"},
+	"Europe/Saratov":                 {short: "rurtw"},
+	"Europe/Simferopol":              {metazone: "Moscow", short: "uasip"},
+	"Europe/Skopje":                  {metazone: "Europe_Central", short: "mkskp", region: "MK"},
+	"Europe/Sofia":                   {metazone: "Europe_Eastern", short: "bgsof", region: "BG"},
+	"Europe/Stockholm":               {metazone: "Europe_Central", short: "sesto", region: "SE"},
+	"Europe/Tallinn":                 {metazone: "Europe_Eastern", short: "eetll", region: "EE"},
+	"Europe/Tirane":                  {metazone: "Europe_Central", short: "altia", region: "AL"},
+	"Europe/Ulyanovsk":               {short: "ruuly"},
+	"Europe/Vaduz":                   {metazone: "Europe_Central", short: "livdz", region: "LI"},
+	"Europe/Vatican":                 {metazone: "Europe_Central", short: "vavat", region: "VA"},
+	"Europe/Vienna":                  {metazone: "Europe_Central", short: "atvie", region: "AT"},
+	"Europe/Vilnius":                 {metazone: "Europe_Eastern", short: "ltvno", region: "LT"},
+	"Europe/Volgograd":               {metazone: "Volgograd", short: "ruvog"},
+	"Europe/Warsaw":                  {metazone: "Europe_Central", short: "plwaw", region: "PL"},
+	"Europe/Zagreb":                  {metazone: "Europe_Central", short: "hrzag", region: "HR"},
+	"Europe/Zurich":                  {metazone: "Europe_Central", short: "chzrh", region: "CH"},
+	"Indian/Antananarivo":            {metazone: "Africa_Eastern", short: "mgtnr", region: "MG"},
+	"Indian/Chagos":                  {metazone: "Indian_Ocean", short: "iodga", region: "IO"},
+	"Indian/Christmas":               {metazone: "Christmas", short: "cxxch", region: "CX"},
+	"Indian/Cocos":                   {metazone: "Cocos", short: "cccck", region: "CC"},
+	"Indian/Comoro":                  {metazone: "Africa_Eastern", short: "kmyva", region: "KM"},
+	"Indian/Kerguelen":               {metazone: "French_Southern", short: "tfpfr", region: "TF"},
+	"Indian/Mahe":                    {metazone: "Seychelles", short: "scmaw", region: "SC"},
+	"Indian/Maldives":                {metazone: "Maldives", short: "mvmle", region: "MV"},
+	"Indian/Mauritius":               {metazone: "Mauritius", short: "muplu", region: "MU"},
+	"Indian/Mayotte":                 {metazone: "Africa_Eastern", short: "ytmam", region: "YT"},
+	"Indian/Reunion":                 {metazone: "Reunion", short: "rereu", region: "RE"},
+	"Pacific/Apia":                   {metazone: "Apia", short: "wsapw", region: "WS"},
+	"Pacific/Auckland":               {metazone: "New_Zealand", short: "nzakl", region: "NZ"},
+	"Pacific/Bougainville":           {short: "pgraw"},
+	"Pacific/Chatham":                {metazone: "Chatham", short: "nzcht"},
+	"Pacific/Chuuk":                  {metazone: "Truk", short: "fmtkk"},
+	"Pacific/Easter":                 {metazone: "Easter", short: "clipc"},
+	"Pacific/Efate":                  {metazone: "Vanuatu", short: "vuvli", region: "VU"},
+	"Pacific/Fakaofo":                {metazone: "Tokelau", short: "tkfko", region: "TK"},
+	"Pacific/Fiji":                   {metazone: "Fiji", short: "fjsuv", region: "FJ"},
+	"Pacific/Funafuti":               {metazone: "Tuvalu", short: "tvfun", region: "TV"},
+	"Pacific/Galapagos":              {metazone: "Galapagos", short: "ecgps"},
+	"Pacific/Gambier":                {metazone: "Gambier", short: "pfgmr"},
+	"Pacific/Guadalcanal":            {metazone: "Solomon", short: "sbhir", region: "SB"},
+	"Pacific/Guam":                   {metazone: "Chamorro", short: "gugum", region: "GU"},
+	"Pacific/Honolulu":               {metazone: "Hawaii_Aleutian", short: "ushnl"},
+	"Pacific/Kanton":                 {metazone: "Phoenix_Islands", short: "kipho"},
+	"Pacific/Kiritimati":             {metazone: "Line_Islands", short: "kicxi"},
+	"Pacific/Kosrae":                 {metazone: "Kosrae", short: "fmksa"},
+	"Pacific/Kwajalein":              {metazone: "Marshall_Islands", short: "mhkwa"},
+	"Pacific/Majuro":                 {metazone: "Marshall_Islands", short: "mhmaj", region: "MH"},
+	"Pacific/Marquesas":              {metazone: "Marquesas", short: "pfnhv"},
+	"Pacific/Midway":                 {metazone: "Samoa", short: "ummdy"},
+	"Pacific/Nauru":                  {metazone: "Nauru", short: "nrinu", region: "NR"},
+	"Pacific/Niue":                   {metazone: "Niue", short: "nuiue", region: "NU"},
+	"Pacific/Norfolk":                {metazone: "Norfolk", short: "nfnlk", region: "NF"},
+	"Pacific/Noumea":                 {metazone: "New_Caledonia", short: "ncnou", region: "NC"},
+	"Pacific/Pago_Pago":              {metazone: "Samoa", short: "asppg", region: "AS"},
+	"Pacific/Palau":                  {metazone: "Palau", short: "pwror", region: "PW"},
+	"Pacific/Pitcairn":               {metazone: "Pitcairn", short: "pnpcn", region: "PN"},
+	"Pacific/Pohnpei":                {metazone: "Ponape", short: "fmpni"},
+	"Pacific/Port_Moresby":           {metazone: "Papua_New_Guinea", short: "pgpom"},
+	"Pacific/Rarotonga":              {metazone: "Cook", short: "ckrar", region: "CK"},
+	"Pacific/Saipan":                 {metazone: "Chamorro", short: "mpspn", region: "MP"},
+	"Pacific/Tahiti":                 {metazone: "Tahiti", short: "pfppt"},
+	"Pacific/Tarawa":                 {metazone: "Gilbert_Islands", short: "kitrw"},
+	"Pacific/Tongatapu":              {metazone: "Tonga", short: "totbu", region: "TO"},
+	"Pacific/Wake":                   {metazone: "Wake", short: "umawk"},
+	"Pacific/Wallis":                 {metazone: "Wallis", short: "wfmau", region: "WF"},
+}
+
+// zoneAliases is the canonical IDs of aliases of time zones.
+var zoneAliases = map[string]string{
+	"Africa/Asmera":                    "Africa/Asmara",
+	"Africa/Timbuktu":                  "Africa/Bamako",
+	"America/Argentina/ComodRivadavia": "America/Argentina/Catamarca",
+	"America/Atka":                     "America/Adak",
+	"America/Buenos_Aires":             "America/Argentina/Buenos_Aires",
+	"America/Catamarca":                "America/Argentina/Catamarca",
+	"America/Coral_Harbour":            "America/Atikokan",
+	"America/Cordoba":                  "America/Argentina/Cordoba",
+	"America/Ensenada":                 "America/Tijuana",
+	"America/Fort_Wayne":               "America/Indiana/Indianapolis",
+	"America/Godthab":                  "America/Nuuk",
+	"America/Indianapolis":             "America/Indiana/Indianapolis",
+	"America/Jujuy":                    "America/Argentina/Jujuy",
+	"America/Knox_IN":                  "America/Indiana/Knox",
+	"America/Louisville":               "America/Kentucky/Louisville",
+	"America/Mendoza":                  "America/Argentina/Mendoza",
+	"America/Montreal":                 "America/Toronto",
+	"America/Nipigon":                  "America/Toronto",
+	"America/Pangnirtung":              "America/Iqaluit",
+	"America/Porto_Acre":               "America/Rio_Branco",
+	"America/Rainy_River":              "America/Winnipeg",
+	"America/Rosario":                  "America/Argentina/Cordoba",
+	"America/Santa_Isabel":             "America/Tijuana",
+	"America/Shiprock":                 "America/Denver",
+	"America/Thunder_Bay":              "America/Toronto",
+	"America/Virgin":                   "America/St_Thomas",
+	"America/Yellowknife":              "America/Edmonton",
+	"Antarctica/South_Pole":            "Antarctica/McMurdo",
+	"Asia/Ashkhabad":                   "Asia/Ashgabat",
+	"Asia/Calcutta":                    "Asia/Kolkata",
+	"Asia/Choibalsan":                  "Asia/Ulaanbaatar",
+	"Asia/Chongqing":                   "Asia/Shanghai",
+	"Asia/Chungking":                   "Asia/Shanghai",
+	"Asia/Dacca":                       "Asia/Dhaka",
+	"Asia/Harbin":                      "Asia/Shanghai",
+	"Asia/Istanbul":                    "Europe/Istanbul",
+	"Asia/Kashgar":                     "Asia/Urumqi",
+	"Asia/Katmandu":                    "Asia/Kathmandu",
+	"Asia/Macao":                       "Asia/Macau",
+	"Asia/Rangoon":                     "Asia/Yangon",
+	"Asia/Saigon":                      "Asia/Ho_Chi_Minh",
+	"Asia/Tel_Aviv":                    "Asia/Jerusalem",
+	"Asia/Thimbu":                      "Asia/Thimphu",
+	"Asia/Ujung_Pandang":               "Asia/Makassar",
+	"Asia/Ulan_Bator":                  "Asia/Ulaanbaatar",
+	"Atlantic/Faeroe":                  "Atlantic/Faroe",
+	"Atlantic/Jan_Mayen":               "Arctic/Longyearbyen",
+	"Australia/ACT":                    "Australia/Sydney",
+	"Australia/Canberra":               "Australia/Sydney",
+	"Australia/Currie":                 "Australia/Hobart",
+	"Australia/LHI":                    "Australia/Lord_Howe",
+	"Australia/NSW":                    "Australia/Sydney",
+	"Australia/North":                  "Australia/Darwin",
+	"Australia/Queensland":             "Australia/Brisbane",
+	"Australia/South":                  "Australia/Adelaide",
+	"Australia/Tasmania":               "Australia/Hobart",
+	"Australia/Victoria":               "Australia/Melbourne",
+	"Australia/West":                   "Australia/Perth",
+	"Australia/Yancowinna":             "Australia/Broken_Hill",
+	"Brazil/Acre":                      "America/Rio_Branco",
+	"Brazil/DeNoronha":                 "America/Noronha",
+	"Brazil/East":                      "America/Sao_Paulo",
+	"Brazil/West":                      "America/Manaus",
+	"CET":                              "Europe/Brussels",
+	"CST6CDT":                          "America/Chicago",
+	"Canada/Atlantic":                  "America/Halifax",
+	"Canada/Central":                   "America/Winnipeg",
+	"Canada/East-Saskatchewan":         "America/Regina",
+	"Canada/Eastern":                   "America/Toronto",
+	"Canada/Mountain":                  "America/Edmonton",
+	"Canada/Newfoundland":              "America/St_Johns",
+	"Canada/Pacific":                   "America/Vancouver",
+	"Canada/Saskatchewan":              "America/Regina",
+	"Canada/Yukon":                     "America/Whitehorse",
+	"Chile/Continental":                "America/Santiago",
+	"Chile/EasterIsland":               "Pacific/Easter",
+	"Cuba":                             "America/Havana",
+	"EET":                              "Europe/Athens",
+	"EST":                              "America/Panama",
+	"EST5EDT":                          "America/New_York",
+	"Egypt":                            "Africa/Cairo",
+	"Eire":                             "Europe/Dublin",
+	"Etc/GMT+0":                        "Etc/GMT",
+	"Etc/GMT-0":                        "Etc/GMT",
+	"Etc/GMT0":                         "Etc/GMT",
+	"Etc/Greenwich":                    "Etc/GMT",
+	"Etc/UCT":                          "Etc/UTC",
+	"Etc/Universal":                    "Etc/UTC",
+	"Etc/Zulu":                         "Etc/UTC",
+	"Europe/Belfast":                   "Europe/London",
+	"Europe/Kiev":                      "Europe/Kyiv",
+	"Europe/Nicosia":                   "Asia/Nicosia",
+	"Europe/Tiraspol":                  "Europe/Chisinau",
+	"Europe/Uzhgorod":                  "Europe/Kyiv",
+	"Europe/Zaporozhye":                "Europe/Kyiv",
+	"GB":                               "Europe/London",
+	"GB-Eire":                          "Europe/London",
+	"GMT":                              "Etc/GMT",
+	"GMT+0":                            "Etc/GMT",
+	"GMT-0":                            "Etc/GMT",
+	"GMT0":                             "Etc/GMT",
+	"Greenwich":                        "Etc/GMT",
+	"HST":                              "Pacific/Honolulu",
+	"Hongkong":                         "Asia/Hong_Kong",
+	"Iceland":                          "Atlantic/Reykjavik",
+	"Iran":                             "Asia/Tehran",
+	"Israel":                           "Asia/Jerusalem",
+	"Jamaica":                          "America/Jamaica",
+	"Japan":                            "Asia/Tokyo",
+	"Kwajalein":                        "Pacific/Kwajalein",
+	"Libya":                            "Africa/Tripoli",
+	"MET":                              "Europe/Brussels",
+	"MST":                              "America/Phoenix",
+	"MST7MDT":                          "America/Denver",
+	"Mexico/BajaNorte":                 "America/Tijuana",
+	"Mexico/BajaSur":                   "America/Mazatlan",
+	"Mexico/General":                   "America/Mexico_City",
+	"NZ":                               "Pacific/Auckland",
+	"NZ-CHAT":                          "Pacific/Chatham",
+	"Navajo":                           "America/Denver",
+	"PRC":                              "Asia/Shanghai",
+	"PST8PDT":                          "America/Los_Angeles",
+	"Pacific/Enderbury":                "Pacific/Kanton",
+	"Pacific/Johnston":                 "Pacific/Honolulu",
+	"Pacific/Ponape":                   "Pacific/Pohnpei",
+	"Pacific/Samoa":                    "Pacific/Pago_Pago",
+	"Pacific/Truk":                     "Pacific/Chuuk",
+	"Pacific/Yap":                      "Pacific/Chuuk",
+	"Poland":                           "Europe/Warsaw",
+	"Portugal":                         "Europe/Lisbon",
+	"ROC":                              "Asia/Taipei",
+	"ROK":                              "Asia/Seoul",
+	"Singapore":                        "Asia/Singapore",
+	"Turkey":                           "Europe/Istanbul",
+	"UCT":                              "Etc/UTC",
+	"US/Alaska":                        "America/Anchorage",
+	"US/Aleutian":                      "America/Adak",
+	"US/Arizona":                       "America/Phoenix",
+	"US/Central":                       "America/Chicago",
+	"US/East-Indiana":                  "America/Indiana/Indianapolis",
+	"US/Eastern":                       "America/New_York",
+	"US/Hawaii":                        "Pacific/Honolulu",
+	"US/Indiana-Starke":                "America/Indiana/Knox",
+	"US/Michigan":                      "America/Detroit",
+	"US/Mountain":                      "America/Denver",
+	"US/Pacific":                       "America/Los_Angeles",
+	"US/Pacific-New":                   "America/Los_Angeles",
+	"US/Samoa":                         "Pacific/Pago_Pago",
+	"UTC":                              "Etc/UTC",
+	"Universal":                        "Etc/UTC",
+	"W-SU":                             "Europe/Moscow",
+	"WET":                              "Europe/Lisbon",
+	"Zulu":                             "Etc/UTC",
+}
+
+// metazoneZones is the zone of a metazone in the world, e.g. for parsing Pacific Time.
+var metazoneZones = map[string]string{
+	"Acre":                     "America/Rio_Branco",
+	"Afghanistan":              "Asia/Kabul",
+	"Africa_Central":           "Africa/Maputo",
+	"Africa_Eastern":           "Africa/Nairobi",
+	"Africa_FarWestern":        "Africa/El_Aaiun",
+	"Africa_Southern":          "Africa/Johannesburg",
+	"Africa_Western":           "Africa/Lagos",
+	"Aktyubinsk":               "Asia/Aqtobe",
+	"Alaska":                   "America/Juneau",
+	"Alaska_Hawaii":            "America/Anchorage",
+	"Almaty":                   "Asia/Almaty",
+	"Amazon":                   "America/Manaus",
+	"America_Central":          "America/Chicago",
+	"America_Eastern":          "America/New_York",
+	"America_Mountain":         "America/Denver",
+	"America_Pacific":          "America/Los_Angeles",
+	"Anadyr":                   "Asia/Anadyr",
+	"Apia":                     "Pacific/Apia",
+	"Aqtau":                    "Asia/Aqtau",
+	"Aqtobe":                   "Asia/Aqtobe",
+	"Arabian":                  "Asia/Riyadh",
+	"Argentina":                "America/Argentina/Buenos_Aires",
+	"Argentina_Western":        "America/Argentina/San_Luis",
+	"Armenia":                  "Asia/Yerevan",
+	"Ashkhabad":                "Asia/Ashgabat",
+	"Atlantic":                 "America/Halifax",
+	"Australia_Central":        "Australia/Adelaide",
+	"Australia_CentralWestern": "Australia/Eucla",
+	"Australia_Eastern":        "Australia/Sydney",
+	"Australia_Western":        "Australia/Perth",
+	"Azerbaijan":               "Asia/Baku",
+	"Azores":                   "Atlantic/Azores",
+	"Baku":                     "Asia/Baku",
+	"Bangladesh":               "Asia/Dhaka",
+	"Bering":                   "America/Adak",
+	"Bhutan":                   "Asia/Thimphu",
+	"Bolivia":                  "America/La_Paz",
+	"Borneo":                   "Asia/Kuching",
+	"Brasilia":                 "America/Sao_Paulo",
+	"British":                  "Europe/London",
+	"Brunei":                   "Asia/Brunei",
+	"Cape_Verde":               "Atlantic/Cape_Verde",
+	"Casey":                    "Antarctica/Casey",
+	"Chamorro":                 "Pacific/Saipan",
+	"Chatham":                  "Pacific/Chatham",
+	"Chile":                    "America/Santiago",
+	"China":                    "Asia/Shanghai",
+	"Christmas":                "Indian/Christmas",
+	"Cocos":                    "Indian/Cocos",
+	"Colombia":                 "America/Bogota",
+	"Cook":                     "Pacific/Rarotonga",
+	"Cuba":                     "America/Havana",
+	"Dacca":                    "Asia/Dhaka",
+	"Davis":                    "Antarctica/Davis",
+	"Dominican":                "America/Santo_Domingo",
+	"DumontDUrville":           "Antarctica/DumontDUrville",
+	"Dushanbe":                 "Asia/Dushanbe",
+	"Dutch_Guiana":             "America/Paramaribo",
+	"East_Timor":               "Asia/Dili",
+	"Easter":                   "Pacific/Easter",
+	"Ecuador":                  "America/Guayaquil",
+	"Europe_Central":           "Europe/Paris",
+	"Europe_Eastern":           "Europe/Bucharest",
+	"Europe_Further_Eastern":   "Europe/Minsk",
+	"Europe_Western":           "Atlantic/Canary",
+	"Falkland":                 "Atlantic/Stanley",
+	"Fiji":                     "Pacific/Fiji",
+	"French_Guiana":            "America/Cayenne",
+	"French_Southern":          "Indian/Kerguelen",
+	"Frunze":                   "Asia/Bishkek",
+	"GMT":                      "Atlantic/Reykjavik",
+	"Galapagos":                "Pacific/Galapagos",
+	"Gambier":                  "Pacific/Gambier",
+	"Georgia":                  "Asia/Tbilisi",
+	"Gilbert_Islands":          "Pacific/Tarawa",
+	"Goose_Bay":                "America/Goose_Bay",
+	"Greenland":                "America/Nuuk",
+	"Greenland_Central":        "America/Scoresbysund",
+	"Greenland_Eastern":        "America/Scoresbysund",
+	"Greenland_Western":        "America/Nuuk",
+	"Guam":                     "Pacific/Guam",
+	"Gulf":                     "Asia/Dubai",
+	"Guyana":                   "America/Guyana",
+	"Hawaii_Aleutian":          "Pacific/Honolulu",
+	"Hong_Kong":                "Asia/Hong_Kong",
+	"Hovd":                     "Asia/Hovd",
+	"India":                    "Asia/Kolkata",
+	"Indian_Ocean":             "Indian/Chagos",
+	"Indochina":                "Asia/Bangkok",
+	"Indonesia_Central":        "Asia/Makassar",
+	"Indonesia_Eastern":        "Asia/Jayapura",
+	"Indonesia_Western":        "Asia/Jakarta",
+	"Iran":                     "Asia/Tehran",
+	"Irish":                    "Europe/Dublin",
+	"Irkutsk":                  "Asia/Irkutsk",
+	"Israel":                   "Asia/Jerusalem",
+	"Japan":                    "Asia/Tokyo",
+	"Kamchatka":                "Asia/Kamchatka",
+	"Karachi":                  "Asia/Karachi",
+	"Kazakhstan":               "Asia/Almaty",
+	"Kazakhstan_Eastern":       "Asia/Almaty",
+	"Kazakhstan_Western":       "Asia/Aqtobe",
+	"Kizilorda":                "Asia/Qyzylorda",
+	"Korea":                    "Asia/Seoul",
+	"Kosrae":                   "Pacific/Kosrae",
+	"Krasnoyarsk":              "Asia/Krasnoyarsk",
+	"Kuybyshev":                "Europe/Samara",
+	"Kwajalein":                "Pacific/Kwajalein",
+	"Kyrgystan":                "Asia/Bishkek",
+	"Lanka":                    "Asia/Colombo",
+	"Liberia":                  "Africa/Monrovia",
+	"Line_Islands":             "Pacific/Kiritimati",
+	"Lord_Howe":                "Australia/Lord_Howe",
+	"Macau":                    "Asia/Macau",
+	"Magadan":                  "Asia/Magadan",
+	"Malaya":                   "Asia/Kuala_Lumpur",
+	"Malaysia":                 "Asia/Kuching",
+	"Maldives":                 "Indian/Maldives",
+	"Marquesas":                "Pacific/Marquesas",
+	"Marshall_Islands":         "Pacific/Majuro",
+	"Mauritius":                "Indian/Mauritius",
+	"Mawson":                   "Antarctica/Mawson",
+	"Mexico_Pacific":           "America/Mazatlan",
+	"Mongolia":                 "Asia/Ulaanbaatar",
+	"Moscow":                   "Europe/Moscow",
+	"Myanmar":                  "Asia/Yangon",
+	"Nauru":                    "Pacific/Nauru",
+	"Nepal":                    "Asia/Kathmandu",
+	"New_Caledonia":            "Pacific/Noumea",
+	"New_Zealand":              "Pacific/Auckland",
+	"Newfoundland":             "America/St_Johns",
+	"Niue":                     "Pacific/Niue",
+	"Norfolk":                  "Pacific/Norfolk",
+	"Noronha":                  "America/Noronha",
+	"North_Mariana":            "Pacific/Saipan",
+	"Novosibirsk":              "Asia/Novosibirsk",
+	"Omsk":                     "Asia/Omsk",
+	"Oral":                     "Asia/Oral",
+	"Pakistan":                 "Asia/Karachi",
+	"Palau":                    "Pacific/Palau",
+	"Papua_New_Guinea":         "Pacific/Port_Moresby",
+	"Paraguay":                 "America/Asuncion",
+	"Peru":                     "America/Lima",
+	"Philippines":              "Asia/Manila",
+	"Phoenix_Islands":          "Pacific/Kanton",
+	"Pierre_Miquelon":          "America/Miquelon",
+	"Pitcairn":                 "Pacific/Pitcairn",
+	"Ponape":                   "Pacific/Pohnpei",
+	"Pyongyang":                "Asia/Pyongyang",
+	"Qyzylorda":                "Asia/Qyzylorda",
+	"Reunion":                  "Indian/Reunion",
+	"Rothera":                  "Antarctica/Rothera",
+	"Sakhalin":                 "Asia/Sakhalin",
+	"Samara":                   "Europe/Samara",
+	"Samarkand":                "Asia/Samarkand",
+	"Samoa":                    "Pacific/Pago_Pago",
+	"Seychelles":               "Indian/Mahe",
+	"Shevchenko":               "Asia/Aqtau",
+	"Singapore":                "Asia/Singapore",
+	"Solomon":                  "Pacific/Guadalcanal",
+	"South_Georgia":            "Atlantic/South_Georgia",
+	"Suriname":                 "America/Paramaribo",
+	"Sverdlovsk":               "Asia/Yekaterinburg",
+	"Syowa":                    "Antarctica/Syowa",
+	"Tahiti":                   "Pacific/Tahiti",
+	"Taipei":                   "Asia/Taipei",
+	"Tajikistan":               "Asia/Dushanbe",
+	"Tashkent":                 "Asia/Tashkent",
+	"Tbilisi":                  "Asia/Tbilisi",
+	"Tokelau":                  "Pacific/Fakaofo",
+	"Tonga":                    "Pacific/Tongatapu",
+	"Truk":                     "Pacific/Chuuk",
+	"Turkey":                   "Europe/Istanbul",
+	"Turkmenistan":             "Asia/Ashgabat",
+	"Tuvalu":                   "Pacific/Funafuti",
+	"Uralsk":                   "Asia/Oral",
+	"Uruguay":                  "America/Montevideo",
+	"Urumqi":                   "Asia/Urumqi",
+	"Uzbekistan":               "Asia/Tashkent",
+	"Vanuatu":                  "Pacific/Efate",
+	"Venezuela":                "America/Caracas",
+	"Vladivostok":              "Asia/Vladivostok",
+	"Volgograd":                "Europe/Volgograd",
+	"Vostok":                   "Antarctica/Vostok",
+	"Wake":                     "Pacific/Wake",
+	"Wallis":                   "Pacific/Wallis",
+	"Yakutsk":                  "Asia/Yakutsk",
+	"Yekaterinburg":            "Asia/Yekaterinburg",
+	"Yerevan":                  "Asia/Yerevan",
+	"Yukon":                    "America/Whitehorse",
+}
+
+// zoneNamesEN is the built-in English names of time zones.
+var zoneNamesEN = &zoneNames{
+	gmtFormat:     "GMT{0}",
+	gmtZeroFormat: "GMT",
+	hourFormat:    "+HH:mm;-HH:mm",
+	regionFormat:  "{0} Time",
+	metazones: map[string]*zoneNameSet{
+		"Acre":                     {long: [3]string{"Acre Time", "Acre Standard Time", "Acre Summer Time"}},
+		"Afghanistan":              {long: [3]string{"", "Afghanistan Time", ""}},
+		"Africa_Central":           {long: [3]string{"", "Central Africa Time", ""}},
+		"Africa_Eastern":           {long: [3]string{"", "East Africa Time", ""}},
+		"Africa_Southern":          {long: [3]string{"", "South Africa Standard Time", ""}},
+		"Africa_Western":           {long: [3]string{"West Africa Time", "West Africa Standard Time", "West Africa Summer Time"}},
+		"Alaska":                   {long: [3]string{"Alaska Time", "Alaska Standard Time", "Alaska Daylight Time"}, short: [3]string{"AKT", "AKST", "AKDT"}},
+		"Almaty":                   {long: [3]string{"Almaty Time", "Almaty Standard Time", "Almaty Summer Time"}},
+		"Amazon":                   {long: [3]string{"Amazon Time", "Amazon Standard Time", "Amazon Summer Time"}},
+		"America_Central":          {long: [3]string{"Central Time", "Central Standard Time", "Central Daylight Time"}, short: [3]string{"CT", "CST", "CDT"}},
+		"America_Eastern":          {long: [3]string{"Eastern Time", "Eastern Standard Time", "Eastern Daylight Time"}, short: [3]string{"ET", "EST", "EDT"}},
+		"America_Mountain":         {long: [3]string{"Mountain Time", "Mountain Standard Time", "Mountain Daylight Time"}, short: [3]string{"MT", "MST", "MDT"}},
+		"America_Pacific":          {long: [3]string{"Pacific Time", "Pacific Standard Time", "Pacific Daylight Time"}, short: [3]string{"PT", "PST", "PDT"}},
+		"Anadyr":                   {long: [3]string{"Anadyr Time", "Anadyr Standard Time", "Anadyr Summer Time"}},
+		"Apia":                     {long: [3]string{"Apia Time", "Apia Standard Time", "Apia Daylight Time"}},
+		"Aqtau":                    {long: [3]string{"Aqtau Time", "Aqtau Standard Time", "Aqtau Summer Time"}},
+		"Aqtobe":                   {long: [3]string{"Aqtobe Time", "Aqtobe Standard Time", "Aqtobe Summer Time"}},
+		"Arabian":                  {long: [3]string{"Arabian Time", "Arabian Standard Time", "Arabian Daylight Time"}},
+		"Argentina":                {long: [3]string{"Argentina Time", "Argentina Standard Time", "Argentina Summer Time"}},
+		"Argentina_Western":        {long: [3]string{"Western Argentina Time", "Western Argentina Standard Time", "Western Argentina Summer Time"}},
+		"Armenia":                  {long: [3]string{"Armenia Time", "Armenia Standard Time", "Armenia Summer Time"}},
+		"Atlantic":                 {long: [3]string{"Atlantic Time", "Atlantic Standard Time", "Atlantic Daylight Time"}, short: [3]string{"AT", "AST", "ADT"}},
+		"Australia_Central":        {long: [3]string{"Central Australia Time", "Australian Central Standard Time", "Australian Central Daylight Time"}},
+		"Australia_CentralWestern": {long: [3]string{"Australian Central Western Time", "Australian Central Western Standard Time", "Australian Central Western Daylight Time"}},
+		"Australia_Eastern":        {long: [3]string{"Eastern Australia Time", "Australian Eastern Standard Time", "Australian Eastern Daylight Time"}},
+		"Australia_Western":        {long: [3]string{"Western Australia Time", "Australian Western Standard Time", "Australian Western Daylight Time"}},
+		"Azerbaijan":               {long: [3]string{"Azerbaijan Time", "Azerbaijan Standard Time", "Azerbaijan Summer Time"}},
+		"Azores":                   {long: [3]string{"Azores Time", "Azores Standard Time", "Azores Summer Time"}},
+		"Bangladesh":               {long: [3]string{"Bangladesh Time", "Bangladesh Standard Time", "Bangladesh Summer Time"}},
+		"Bhutan":                   {long: [3]string{"", "Bhutan Time", ""}},
+		"Bolivia":                  {long: [3]string{"", "Bolivia Time", ""}},
+		"Brasilia":                 {long: [3]string{"Brasilia Time", "Brasilia Standard Time", "Brasilia Summer Time"}},
+		"Brunei":                   {long: [3]string{"", "Brunei Darussalam Time", ""}},
+		"Cape_Verde":               {long: [3]string{"Cape Verde Time", "Cape Verde Standard Time", "Cape Verde Summer Time"}},
+		"Casey":                    {long: [3]string{"", "Casey Time", ""}},
+		"Chamorro":                 {long: [3]string{"", "Chamorro Standard Time", ""}},
+		"Chatham":                  {long: [3]string{"Chatham Time", "Chatham Standard Time", "Chatham Daylight Time"}},
+		"Chile":                    {long: [3]string{"Chile Time", "Chile Standard Time", "Chile Summer Time"}},
+		"China":                    {long: [3]string{"China Time", "China Standard Time", "China Daylight Time"}},
+		"Choibalsan":               {long: [3]string{"Choibalsan Time", "Choibalsan Standard Time", "Choibalsan Summer Time"}},
+		"Christmas":                {long: [3]string{"", "Christmas Island Time", ""}},
+		"Cocos":                    {long: [3]string{"", "Cocos Islands Time", ""}},
+		"Colombia":                 {long: [3]string{"Colombia Time", "Colombia Standard Time", "Colombia Summer Time"}},
+		"Cook":                     {long: [3]string{"Cook Islands Time", "Cook Islands Standard Time", "Cook Islands Half Summer Time"}},
+		"Cuba":                     {long: [3]string{"Cuba Time", "Cuba Standard Time", "Cuba Daylight Time"}},
+		"Davis":                    {long: [3]string{"", "Davis Time", ""}},
+		"DumontDUrville":           {long: [3]string{"", "Dumont-d’Urville Time", ""}},
+		"East_Timor":               {long: [3]string{"", "East Timor Time", ""}},
+		"Easter":                   {long: [3]string{"Easter Island Time", "Easter Island Standard Time", "Easter Island Summer Time"}},
+		"Ecuador":                  {long: [3]string{"", "Ecuador Time", ""}},
+		"Europe_Central":           {long: [3]string{"Central European Time", "Central European Standard Time", "Central European Summer Time"}},
+		"Europe_Eastern":           {long: [3]string{"Eastern European Time", "Eastern European Standard Time", "Eastern European Summer Time"}},
+		"Europe_Further_Eastern":   {long: [3]string{"", "Further-eastern European Time", ""}},
+		"Europe_Western":           {long: [3]string{"Western European Time", "Western European Standard Time", "Western European Summer Time"}},
+		"Falkland":                 {long: [3]string{"Falkland Islands Time", "Falkland Islands Standard Time", "Falkland Islands Summer Time"}},
+		"Fiji":                     {long: [3]string{"Fiji Time", "Fiji Standard Time", "Fiji Summer Time"}},
+		"French_Guiana":            {long: [3]string{"", "French Guiana Time", ""}},
+		"French_Southern":          {long: [3]string{"", "French Southern & Antarctic Time", ""}},
+		"GMT":                      {long: [3]string{"", "Greenwich Mean Time", ""}, short: [3]string{"", "GMT", ""}},
+		"Galapagos":                {long: [3]string{"", "Galapagos Time", ""}},
+		"Gambier":                  {long: [3]string{"", "Gambier Time", ""}},
+		"Georgia":                  {long: [3]string{"Georgia Time", "Georgia Standard Time", "Georgia Summer Time"}},
+		"Gilbert_Islands":          {long: [3]string{"", "Gilbert Islands Time", ""}},
+		"Greenland_Eastern":        {long: [3]string{"East Greenland Time", "East Greenland Standard Time", "East Greenland Summer Time"}},
+		"Greenland_Western":        {long: [3]string{"West Greenland Time", "West Greenland Standard Time", "West Greenland Summer Time"}},
+		"Guam":                     {long: [3]string{"", "Guam Standard Time", ""}},
+		"Gulf":                     {long: [3]string{"", "Gulf Standard Time", ""}},
+		"Guyana":                   {long: [3]string{"", "Guyana Time", ""}},
+		"Hawaii_Aleutian":          {long: [3]string{"Hawaii-Aleutian Time", "Hawaii-Aleutian Standard Time", "Hawaii-Aleutian Daylight Time"}, short: [3]string{"HAT", "HAST", "HADT"}},
+		"Hong_Kong":                {long: [3]string{"Hong Kong Time", "Hong Kong Standard Time", "Hong Kong Summer Time"}},
+		"Hovd":                     {long: [3]string{"Hovd Time", "Hovd Standard Time", "Hovd Summer Time"}},
+		"India":                    {long: [3]string{"", "India Standard Time", ""}},
+		"Indian_Ocean":             {long: [3]string{"", "Indian Ocean Time", ""}},
+		"Indochina":                {long: [3]string{"", "Indochina Time", ""}},
+		"Indonesia_Central":        {long: [3]string{"", "Central Indonesia Time", ""}},
+		"Indonesia_Eastern":        {long: [3]string{"", "Eastern Indonesia Time", ""}},
+		"Indonesia_Western":        {long: [3]string{"", "Western Indonesia Time", ""}},
+		"Iran":                     {long: [3]string{"Iran Time", "Iran Standard Time", "Iran Daylight Time"}},
+		"Irkutsk":                  {long: [3]string{"Irkutsk Time", "Irkutsk Standard Time", "Irkutsk Summer Time"}},
+		"Israel":                   {long: [3]string{"Israel Time", "Israel Standard Time", "Israel Daylight Time"}},
+		"Japan":                    {long: [3]string{"Japan Time", "Japan Standard Time", "Japan Daylight Time"}},
+		"Kamchatka":                {long: [3]string{"Petropavlovsk-Kamchatski Time", "Petropavlovsk-Kamchatski Standard Time", "Petropavlovsk-Kamchatski Summer Time"}},
+		"Kazakhstan_Eastern":       {long: [3]string{"", "East Kazakhstan Time", ""}},
+		"Kazakhstan_Western":       {long: [3]string{"", "West Kazakhstan Time", ""}},
+		"Korea":                    {long: [3]string{"Korean Time", "Korean Standard Time", "Korean Daylight Time"}},
+		"Kosrae":                   {long: [3]string{"", "Kosrae Time", ""}},
+		"Krasnoyarsk":              {long: [3]string{"Krasnoyarsk Time", "Krasnoyarsk Standard Time", "Krasnoyarsk Summer Time"}},
+		"Kyrgystan":                {long: [3]string{"", "Kyrgyzstan Time", ""}},
+		"Lanka":                    {long: [3]string{"", "Lanka Time", ""}},
+		"Line_Islands":             {long: [3]string{"", "Line Islands Time", ""}},
+		"Lord_Howe":                {long: [3]string{"Lord Howe Time", "Lord Howe Standard Time", "Lord Howe Daylight Time"}},
+		"Macau":                    {long: [3]string{"Macao Time", "Macao Standard Time", "Macao Summer Time"}},
+		"Macquarie":                {long: [3]string{"", "Macquarie Island Time", ""}},
+		"Magadan":                  {long: [3]string{"Magadan Time", "Magadan Standard Time", "Magadan Summer Time"}},
+		"Malaysia":                 {long: [3]string{"", "Malaysia Time", ""}},
+		"Maldives":                 {long: [3]string{"", "Maldives Time", ""}},
+		"Marquesas":                {long: [3]string{"", "Marquesas Time", ""}},
+		"Marshall_Islands":         {long: [3]string{"", "Marshall Islands Time", ""}},
+		"Mauritius":                {long: [3]string{"Mauritius Time", "Mauritius Standard Time", "Mauritius Summer Time"}},
+		"Mawson":                   {long: [3]string{"", "Mawson Time", ""}},
+		"Mexico_Northwest":         {long: [3]string{"Northwest Mexico Time", "Northwest Mexico Standard Time", "Northwest Mexico Daylight Time"}},
+		"Mexico_Pacific":           {long: [3]string{"Mexican Pacific Time", "Mexican Pacific Standard Time", "Mexican Pacific Daylight Time"}},
+		"Mongolia":                 {long: [3]string{"Ulaanbaatar Time", "Ulaanbaatar Standard Time", "Ulaanbaatar Summer Time"}},
+		"Moscow":                   {long: [3]string{"Moscow Time", "Moscow Standard Time", "Moscow Summer Time"}},
+		"Myanmar":                  {long: [3]string{"", "Myanmar Time", ""}},
+		"Nauru":                    {long: [3]string{"", "Nauru Time", ""}},
+		"Nepal":                    {long: [3]string{"", "Nepal Time", ""}},
+		"New_Caledonia":            {long: [3]string{"New Caledonia Time", "New Caledonia Standard Time", "New Caledonia Summer Time"}},
+		"New_Zealand":              {long: [3]string{"New Zealand Time", "New Zealand Standard Time", "New Zealand Daylight Time"}},
+		"Newfoundland":             {long: [3]string{"Newfoundland Time", "Newfoundland Standard Time", "Newfoundland Daylight Time"}},
+		"Niue":                     {long: [3]string{"", "Niue Time", ""}},
+		"Norfolk":                  {long: [3]string{"Norfolk Island Time", "Norfolk Island Standard Time", "Norfolk Island Daylight Time"}},
+		"Noronha":                  {long: [3]string{"Fernando de Noronha Time", "Fernando de Noronha Standard Time", "Fernando de Noronha Summer Time"}},
+		"North_Mariana":            {long: [3]string{"", "North Mariana Islands Time", ""}},
+		"Novosibirsk":              {long: [3]string{"Novosibirsk Time", "Novosibirsk Standard Time", "Novosibirsk Summer Time"}},
+		"Omsk":                     {long: [3]string{"Omsk Time", "Omsk Standard Time", "Omsk Summer Time"}},
+		"Pakistan":                 {long: [3]string{"Pakistan Time", "Pakistan Standard Time", "Pakistan Summer Time"}},
+		"Palau":                    {long: [3]string{"", "Palau Time", ""}},
+		"Papua_New_Guinea":         {long: [3]string{"", "Papua New Guinea Time", ""}},
+		"Paraguay":                 {long: [3]string{"Paraguay Time", "Paraguay Standard Time", "Paraguay Summer Time"}},
+		"Peru":                     {long: [3]string{"Peru Time", "Peru Standard Time", "Peru Summer Time"}},
+		"Philippines":              {long: [3]string{"Philippine Time", "Philippine Standard Time", "Philippine Summer Time"}},
+		"Phoenix_Islands":          {long: [3]string{"", "Phoenix Islands Time", ""}},
+		"Pierre_Miquelon":          {long: [3]string{"St. Pierre & Miquelon Time", "St. Pierre & Miquelon Standard Time", "St. Pierre & Miquelon Daylight Time"}},
+		"Pitcairn":                 {long: [3]string{"", "Pitcairn Time", ""}},
+		"Ponape":                   {long: [3]string{"", "Ponape Time", ""}},
+		"Pyongyang":                {long: [3]string{"", "Pyongyang Time", ""}},
+		"Qyzylorda":                {long: [3]string{"Qyzylorda Time", "Qyzylorda Standard Time", "Qyzylorda Summer Time"}},
+		"Reunion":                  {long: [3]string{"", "Réunion Time", ""}},
+		"Rothera":                  {long: [3]string{"", "Rothera Time", ""}},
+		"Sakhalin":                 {long: [3]string{"Sakhalin Time", "Sakhalin Standard Time", "Sakhalin Summer Time"}},
+		"Samara":                   {long: [3]string{"Samara Time", "Samara Standard Time", "Samara Summer Time"}},
+		"Samoa":                    {long: [3]string{"Samoa Time", "Samoa Standard Time", "Samoa Daylight Time"}},
+		"Seychelles":               {long: [3]string{"", "Seychelles Time", ""}},
+		"Singapore":                {long: [3]string{"", "Singapore Standard Time", ""}},
+		"Solomon":                  {long: [3]string{"", "Solomon Islands Time", ""}},
+		"South_Georgia":            {long: [3]string{"", "South Georgia Time", ""}},
+		"Suriname":                 {long: [3]string{"", "Suriname Time", ""}},
+		"Syowa":                    {long: [3]string{"", "Syowa Time", ""}},
+		"Tahiti":                   {long: [3]string{"", "Tahiti Time", ""}},
+		"Taipei":                   {long: [3]string{"Taipei Time", "Taipei Standard Time", "Taipei Daylight Time"}},
+		"Tajikistan":               {long: [3]string{"", "Tajikistan Time", ""}},
+		"Tokelau":                  {long: [3]string{"", "Tokelau Time", ""}},
+		"Tonga":                    {long: [3]string{"Tonga Time", "Tonga Standard Time", "Tonga Summer Time"}},
+		"Truk":                     {long: [3]string{"", "Chuuk Time", ""}},
+		"Turkmenistan":             {long: [3]string{"Turkmenistan Time", "Turkmenistan Standard Time", "Turkmenistan Summer Time"}},
+		"Tuvalu":                   {long: [3]string{"", "Tuvalu Time", ""}},
+		"Uruguay":                  {long: [3]string{"Uruguay Time", "Uruguay Standard Time", "Uruguay Summer Time"}},
+		"Uzbekistan":               {long: [3]string{"Uzbekistan Time", "Uzbekistan Standard Time", "Uzbekistan Summer Time"}},
+		"Vanuatu":                  {long: [3]string{"Vanuatu Time", "Vanuatu Standard Time", "Vanuatu Summer Time"}},
+		"Venezuela":                {long: [3]string{"", "Venezuela Time", ""}},
+		"Vladivostok":              {long: [3]string{"Vladivostok Time", "Vladivostok Standard Time", "Vladivostok Summer Time"}},
+		"Volgograd":                {long: [3]string{"Volgograd Time", "Volgograd Standard Time", "Volgograd Summer Time"}},
+		"Vostok":                   {long: [3]string{"", "Vostok Time", ""}},
+		"Wake":                     {long: [3]string{"", "Wake Island Time", ""}},
+		"Wallis":                   {long: [3]string{"", "Wallis & Futuna Time", ""}},
+		"Yakutsk":                  {long: [3]string{"Yakutsk Time", "Yakutsk Standard Time", "Yakutsk Summer Time"}},
+		"Yekaterinburg":            {long: [3]string{"Yekaterinburg Time", "Yekaterinburg Standard Time", "Yekaterinburg Summer Time"}},
+		"Yukon":                    {long: [3]string{"", "Yukon Time", ""}},
+	},
+	zones: map[string]*zoneNameSet{
+		"Etc/UTC":          {long: [3]string{"", "Coordinated Universal Time", ""}, short: [3]string{"", "UTC", ""}},
+		"Europe/Dublin":    {long: [3]string{"", "", "Irish Standard Time"}},
+		"Europe/London":    {long: [3]string{"", "", "British Summer Time"}},
+		"Pacific/Honolulu": {short: [3]string{"HST", "HST", "HDT"}},
+	},
+	cities: map[string]string{
+		"America/Ciudad_Juarez": "Ciudad Juárez",
+	},
+	regions: map[string]string{
+		"AD": "Andorra",
+		"AE": "United Arab Emirates",
+		"AF": "Afghanistan",
+		"AG": "Antigua & Barbuda",
+		"AI": "Anguilla",
+		"AL": "Albania",
+		"AM": "Armenia",
+		"AO": "Angola",
+		"AS": "American Samoa",
+		"AT": "Austria",
+		"AW": "Aruba",
+		"AZ": "Azerbaijan",
+		"BA": "Bosnia & Herzegovina",
+		"BB": "Barbados",
+		"BD": "Bangladesh",
+		"BE": "Belgium",
+		"BF": "Burkina Faso",
+		"BG": "Bulgaria",
+		"BH": "Bahrain",
+		"BI": "Burundi",
+		"BJ": "Benin",
+		"BM": "Bermuda",
+		"BN": "Brunei",
+		"BO": "Bolivia",
+		"BQ": "Caribbean Netherlands",
+		"BS": "Bahamas",
+		"BT": "Bhutan",
+		"BW": "Botswana",
+		"BY": "Belarus",
+		"BZ": "Belize",
+		"CC": "Cocos (Keeling) Islands",
+		"CF": "Central African Republic",
+		"CG": "Congo - Brazzaville",
+		"CH": "Switzerland",
+		"CI": "Côte d’Ivoire",
+		"CK": "Cook Islands",
+		"CL": "Chile",
+		"CM": "Cameroon",
+		"CN": "China",
+		"CO": "Colombia",
+		"CR": "Costa Rica",
+		"CU": "Cuba",
+		"CV": "Cape Verde",
+		"CX": "Christmas Island",
+		"CZ": "Czechia",
+		"DE": "Germany",
+		"DJ": "Djibouti",
+		"DK": "Denmark",
+		"DM": "Dominica",
+		"DO": "Dominican Republic",
+		"DZ": "Algeria",
+		"EC": "Ecuador",
+		"EE": "Estonia",
+		"EG": "Egypt",
+		"EH": "Western Sahara",
+		"ER": "Eritrea",
+		"ES": "Spain",
+		"ET": "Ethiopia",
+		"FJ": "Fiji",
+		"FK": "Falkland Islands (Islas Malvinas)",
+		"FO": "Faroe Islands",
+		"FR": "France",
+		"GA": "Gabon",
+		"GB": "United Kingdom",
+		"GD": "Grenada",
+		"GE": "Georgia",
+		"GF": "French Guiana",
+		"GG": "Guernsey",
+		"GH": "Ghana",
+		"GI": "Gibraltar",
+		"GM": "Gambia",
+		"GN": "Guinea",
+		"GQ": "Equatorial Guinea",
+		"GR": "Greece",
+		"GS": "South Georgia & South Sandwich Islands",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guinea-Bissau",
+		"GY": "Guyana",
+		"HK": "Hong Kong",
+		"HN": "Honduras",
+		"HR": "Croatia",
+		"HT": "Haiti",
+		"HU": "Hungary",
+		"IE": "Ireland",
+		"IM": "Isle of Man",
+		"IN": "India",
+		"IO": "British Indian Ocean Territory",
+		"IQ": "Iraq",
+		"IR": "Iran",
+		"IS": "Iceland",
+		"IT": "Italy",
+		"JE": "Jersey",
+		"JM": "Jamaica",
+		"JO": "Jordan",
+		"JP": "Japan",
+		"KE": "Kenya",
+		"KG": "Kyrgyzstan",
+		"KH": "Cambodia",
+		"KM": "Comoros",
+		"KN": "St. Kitts & Nevis",
+		"KP": "North Korea",
+		"KR": "South Korea",
+		"KW": "Kuwait",
+		"KY": "Cayman Islands",
+		"LA": "Laos",
+		"LB": "Lebanon",
+		"LC": "St. Lucia",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Liberia",
+		"LS": "Lesotho",
+		"LT": "Lithuania",
+		"LU": "Luxembourg",
+		"LV": "Latvia",
+		"LY": "Libya",
+		"MA": "Morocco",
+		"MC": "Monaco",
+		"MD": "Moldova",
+		"ME": "Montenegro",
+		"MG": "Madagascar",
+		"MH": "Marshall Islands",
+		"MK": "North Macedonia",
+		"ML": "Mali",
+		"MM": "Myanmar (Burma)",
+		"MO": "Macao",
+		"MP": "Northern Mariana Islands",
+		"MQ": "Martinique",
+		"MR": "Mauritania",
+		"MS": "Montserrat",
+		"MT": "Malta",
+		"MU": "Mauritius",
+		"MV": "Maldives",
+		"MW": "Malawi",
+		"MY": "Malaysia",
+		"MZ": "Mozambique",
+		"NA": "Namibia",
+		"NC": "New Caledonia",
+		"NE": "Niger",
+		"NF": "Norfolk Island",
+		"NG": "Nigeria",
+		"NI": "Nicaragua",
+		"NL": "Netherlands",
+		"NO": "Norway",
+		"NP": "Nepal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "New Zealand",
+		"OM": "Oman",
+		"PA": "Panama",
+		"PE": "Peru",
+		"PH": "Philippines",
+		"PK": "Pakistan",
+		"PL": "Poland",
+		"PM": "St. Pierre & Miquelon",
+		"PN": "Pitcairn Islands",
+		"PR": "Puerto Rico",
+		"PT": "Portugal",
+		"PW": "Palau",
+		"PY": "Paraguay",
+		"QA": "Qatar",
+		"RE": "Réunion",
+		"RO": "Romania",
+		"RS": "Serbia",
+		"RW": "Rwanda",
+		"SA": "Saudi Arabia",
+		"SB": "Solomon Islands",
+		"SC": "Seychelles",
+		"SD": "Sudan",
+		"SE": "Sweden",
+		"SG": "Singapore",
+		"SH": "St. Helena",
+		"SI": "Slovenia",
+		"SJ": "Svalbard & Jan Mayen",
+		"SK": "Slovakia",
+		"SL": "Sierra Leone",
+		"SM": "San Marino",
+		"SN": "Senegal",
+		"SO": "Somalia",
+		"SR": "Suriname",
+		"SS": "South Sudan",
+		"ST": "São Tomé & Príncipe",
+		"SV": "El Salvador",
+		"SX": "Sint Maarten",
+		"SY": "Syria",
+		"SZ": "Eswatini",
+		"TC": "Turks & Caicos Islands",
+		"TD": "Chad",
+		"TF": "French Southern Territories",
+		"TG": "Togo",
+		"TH": "Thailand",
+		"TJ": "Tajikistan",
+		"TK": "Tokelau",
+		"TL": "Timor-Leste",
+		"TM": "Turkmenistan",
+		"TN": "Tunisia",
+		"TO": "Tonga",
+		"TR": "Türkiye",
+		"TT": "Trinidad & Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taiwan",
+		"TZ": "Tanzania",
+		"UA": "Ukraine",
+		"UG": "Uganda",
+		"UY": "Uruguay",
+		"UZ": "Uzbekistan",
+		"VA": "Vatican City",
+		"VC": "St. Vincent & Grenadines",
+		"VE": "Venezuela",
+		"VG": "British Virgin Islands",
+		"VI": "U.S. Virgin Islands",
+		"VN": "Vietnam",
+		"VU": "Vanuatu",
+		"WF": "Wallis & Futuna",
+		"WS": "Samoa",
+		"YE": "Yemen",
+		"YT": "Mayotte",
+		"ZA": "South Africa",
+		"ZM": "Zambia",
+		"ZW": "Zimbabwe",
+	},
+}
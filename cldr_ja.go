@@ -0,0 +1,529 @@
+// Code generated by datefmt-cldrgen; DO NOT EDIT.
+
+//go:build !datefmt_select || datefmt_ja
+// +build !datefmt_select datefmt_ja
+
+package datefmt
+
+func init() {
+	registerLocale("ja", &localeData{
+		calendars: map[string]*calendarNames{
+			"gregorian": {
+				eras: [3][]string{
+					{"紀元前", "西暦"},
+					{"紀元前", "西暦"},
+					{"BC", "AD"},
+				},
+				months: [3][]string{
+					{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+					{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+					{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+				},
+				weekdays: [3][]string{
+					{"日", "月", "火", "水", "木", "金", "土"},
+					{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+					{"日", "月", "火", "水", "木", "金", "土"},
+				},
+				dayPeriods: [3][]string{
+					{"午前", "午後"},
+					{"午前", "午後"},
+					{"午前", "午後"},
+				},
+			},
+		},
+		skeletons: map[string]string{
+			"Bh":         "BK時",
+			"Bhm":        "BK:mm",
+			"Bhms":       "BK:mm:ss",
+			"E":          "EEE",
+			"EBhm":       "BK:mm (E)",
+			"EBhms":      "BK:mm:ss (E)",
+			"EEEEd":      "d日EEEE",
+			"EHm":        "H:mm (E)",
+			"EHms":       "H:mm:ss (E)",
+			"Ed":         "d日(E)",
+			"Ehm":        "aK:mm (E)",
+			"Ehms":       "aK:mm:ss (E)",
+			"Gy":         "Gy年",
+			"GyMMM":      "Gy年M月",
+			"GyMMMEEEEd": "Gy年M月d日EEEE",
+			"GyMMMEd":    "Gy年M月d日(E)",
+			"GyMMMd":     "Gy年M月d日",
+			"GyMd":       "GGGGGy/M/d",
+			"H":          "H時",
+			"Hm":         "H:mm",
+			"Hms":        "H:mm:ss",
+			"Hmsv":       "H:mm:ss v",
+			"Hmv":        "H:mm v",
+			"M":          "M月",
+			"MEEEEd":     "M/dEEEE",
+			"MEd":        "M/d(E)",
+			"MMM":        "M月",
+			"MMMEEEEd":   "M月d日EEEE",
+			"MMMEd":      "M月d日(E)",
+			"MMMMW":      "M月第W週",
+			"MMMMd":      "M月d日",
+			"MMMd":       "M月d日",
+			"Md":         "M/d",
+			"d":          "d日",
+			"h":          "aK時",
+			"hm":         "aK:mm",
+			"hms":        "aK:mm:ss",
+			"hmsv":       "aK:mm:ss v",
+			"hmv":        "aK:mm v",
+			"ms":         "mm:ss",
+			"y":          "y年",
+			"yM":         "y/M",
+			"yMEEEEd":    "y/M/dEEEE",
+			"yMEd":       "y/M/d(E)",
+			"yMM":        "y/MM",
+			"yMMM":       "y年M月",
+			"yMMMEEEEd":  "y年M月d日EEEE",
+			"yMMMEd":     "y年M月d日(E)",
+			"yMMMM":      "y年M月",
+			"yMMMd":      "y年M月d日",
+			"yMd":        "y/M/d",
+			"yw":         "Y年第w週",
+		},
+		dateTime: "{1} {0}",
+		hour:     'H',
+		styles: &stylePatterns{
+			date:     [4]string{"y/MM/dd", "y/MM/dd", "y年M月d日", "y年M月d日EEEE"},
+			time:     [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H時mm分ss秒 zzzz"},
+			dateTime: [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		},
+		periods: &dayPeriodData{
+			rules: []dayPeriodRule{
+				{id: "midnight", from: 0 * 60, before: 0 * 60},
+				{id: "morning1", from: 4 * 60, before: 12 * 60},
+				{id: "afternoon1", from: 12 * 60, before: 16 * 60},
+				{id: "noon", from: 12 * 60, before: 12 * 60},
+				{id: "evening1", from: 16 * 60, before: 19 * 60},
+				{id: "night1", from: 19 * 60, before: 23 * 60},
+				{id: "night2", from: 23 * 60, before: 4 * 60},
+			},
+			names: map[string][3]string{
+				"am":         {"午前", "午前", "午前"},
+				"pm":         {"午後", "午後", "午後"},
+				"midnight":   {"真夜中", "真夜中", "真夜中"},
+				"morning1":   {"朝", "朝", "朝"},
+				"afternoon1": {"昼", "昼", "昼"},
+				"noon":       {"正午", "正午", "正午"},
+				"evening1":   {"夕方", "夕方", "夕方"},
+				"night1":     {"夜", "夜", "夜"},
+				"night2":     {"夜中", "夜中", "夜中"},
+			},
+		},
+		zones: &zoneNames{
+			gmtFormat:     "GMT{0}",
+			gmtZeroFormat: "GMT",
+			hourFormat:    "+HH:mm;-HH:mm",
+			regionFormat:  "{0}時間",
+			metazones: map[string]*zoneNameSet{
+				"Acre":                     {long: [3]string{"アクレ時間", "アクレ標準時", "アクレ夏時間"}},
+				"Afghanistan":              {long: [3]string{"", "アフガニスタン時間", ""}},
+				"Africa_Central":           {long: [3]string{"", "中央アフリカ時間", ""}},
+				"Africa_Eastern":           {long: [3]string{"", "東アフリカ時間", ""}},
+				"Africa_Southern":          {long: [3]string{"", "南アフリカ標準時", ""}},
+				"Africa_Western":           {long: [3]string{"西アフリカ時間", "西アフリカ標準時", "西アフリカ夏時間"}},
+				"Alaska":                   {long: [3]string{"アラスカ時間", "アラスカ標準時", "アラスカ夏時間"}},
+				"Almaty":                   {long: [3]string{"アルトマイ時間", "アルトマイ標準時", "アルマトイ夏時間"}},
+				"Amazon":                   {long: [3]string{"アマゾン時間", "アマゾン標準時", "アマゾン夏時間"}},
+				"America_Central":          {long: [3]string{"アメリカ中部時間", "アメリカ中部標準時", "アメリカ中部夏時間"}},
+				"America_Eastern":          {long: [3]string{"アメリカ東部時間", "アメリカ東部標準時", "アメリカ東部夏時間"}},
+				"America_Mountain":         {long: [3]string{"アメリカ山地時間", "アメリカ山地標準時", "アメリカ山地夏時間"}},
+				"America_Pacific":          {long: [3]string{"アメリカ太平洋時間", "アメリカ太平洋標準時", "アメリカ太平洋夏時間"}},
+				"Anadyr":                   {long: [3]string{"アナディリ時間", "アナディリ標準時", "アナディリ夏時間"}},
+				"Apia":                     {long: [3]string{"アピア時間", "アピア標準時", "アピア夏時間"}},
+				"Aqtau":                    {long: [3]string{"アクタウ時間", "アクタウ標準時", "アクタウ夏時間"}},
+				"Aqtobe":                   {long: [3]string{"アクトベ時間", "アクトベ標準時", "アクトベ夏時間"}},
+				"Arabian":                  {long: [3]string{"アラビア時間", "アラビア標準時", "アラビア夏時間"}},
+				"Argentina":                {long: [3]string{"アルゼンチン時間", "アルゼンチン標準時", "アルゼンチン夏時間"}},
+				"Argentina_Western":        {long: [3]string{"西部アルゼンチン時間", "西部アルゼンチン標準時", "西部アルゼンチン夏時間"}},
+				"Armenia":                  {long: [3]string{"アルメニア時間", "アルメニア標準時", "アルメニア夏時間"}},
+				"Atlantic":                 {long: [3]string{"大西洋時間", "大西洋標準時", "大西洋夏時間"}},
+				"Australia_Central":        {long: [3]string{"オーストラリア中部時間", "オーストラリア中部標準時", "オーストラリア中部夏時間"}},
+				"Australia_CentralWestern": {long: [3]string{"オーストラリア中西部時間", "オーストラリア中西部標準時", "オーストラリア中西部夏時間"}},
+				"Australia_Eastern":        {long: [3]string{"オーストラリア東部時間", "オーストラリア東部標準時", "オーストラリア東部夏時間"}},
+				"Australia_Western":        {long: [3]string{"オーストラリア西部時間", "オーストラリア西部標準時", "オーストラリア西部夏時間"}},
+				"Azerbaijan":               {long: [3]string{"アゼルバイジャン時間", "アゼルバイジャン標準時", "アゼルバイジャン夏時間"}},
+				"Azores":                   {long: [3]string{"アゾレス時間", "アゾレス標準時", "アゾレス夏時間"}},
+				"Bangladesh":               {long: [3]string{"バングラデシュ時間", "バングラデシュ標準時", "バングラデシュ夏時間"}},
+				"Bhutan":                   {long: [3]string{"", "ブータン時間", ""}},
+				"Bolivia":                  {long: [3]string{"", "ボリビア時間", ""}},
+				"Brasilia":                 {long: [3]string{"ブラジリア時間", "ブラジリア標準時", "ブラジリア夏時間"}},
+				"Brunei":                   {long: [3]string{"", "ブルネイ・ダルサラーム時間", ""}},
+				"Cape_Verde":               {long: [3]string{"カーボベルデ時間", "カーボベルデ標準時", "カーボベルデ夏時間"}},
+				"Casey":                    {long: [3]string{"", "ケイシー基地時間", ""}},
+				"Chamorro":                 {long: [3]string{"", "チャモロ時間", ""}},
+				"Chatham":                  {long: [3]string{"チャタム時間", "チャタム標準時", "チャタム夏時間"}},
+				"Chile":                    {long: [3]string{"チリ時間", "チリ標準時", "チリ夏時間"}},
+				"China":                    {long: [3]string{"中国時間", "中国標準時", "中国夏時間"}},
+				"Choibalsan":               {long: [3]string{"チョイバルサン時間", "チョイバルサン標準時", "チョイバルサン夏時間"}},
+				"Christmas":                {long: [3]string{"", "クリスマス島時間", ""}},
+				"Cocos":                    {long: [3]string{"", "ココス諸島時間", ""}},
+				"Colombia":                 {long: [3]string{"コロンビア時間", "コロンビア標準時", "コロンビア夏時間"}},
+				"Cook":                     {long: [3]string{"クック諸島時間", "クック諸島標準時", "クック諸島夏時間"}},
+				"Cuba":                     {long: [3]string{"キューバ時間", "キューバ標準時", "キューバ夏時間"}},
+				"Davis":                    {long: [3]string{"", "デービス基地時間", ""}},
+				"DumontDUrville":           {long: [3]string{"", "デュモン・デュルヴィル基地時間", ""}},
+				"East_Timor":               {long: [3]string{"", "東ティモール時間", ""}},
+				"Easter":                   {long: [3]string{"イースター島時間", "イースター島標準時", "イースター島夏時間"}},
+				"Ecuador":                  {long: [3]string{"", "エクアドル時間", ""}},
+				"Europe_Central":           {long: [3]string{"中央ヨーロッパ時間", "中央ヨーロッパ標準時", "中央ヨーロッパ夏時間"}},
+				"Europe_Eastern":           {long: [3]string{"東ヨーロッパ時間", "東ヨーロッパ標準時", "東ヨーロッパ夏時間"}},
+				"Europe_Further_Eastern":   {long: [3]string{"", "極東ヨーロッパ時間", ""}},
+				"Europe_Western":           {long: [3]string{"西ヨーロッパ時間", "西ヨーロッパ標準時", "西ヨーロッパ夏時間"}},
+				"Falkland":                 {long: [3]string{"フォークランド諸島時間", "フォークランド諸島標準時", "フォークランド諸島夏時間"}},
+				"Fiji":                     {long: [3]string{"フィジー時間", "フィジー標準時", "フィジー夏時間"}},
+				"French_Guiana":            {long: [3]string{"", "仏領ギアナ時間", ""}},
+				"French_Southern":          {long: [3]string{"", "仏領南方南極時間", ""}},
+				"GMT":                      {long: [3]string{"", "グリニッジ標準時", ""}},
+				"Galapagos":                {long: [3]string{"", "ガラパゴス時間", ""}},
+				"Gambier":                  {long: [3]string{"", "ガンビエ諸島時間", ""}},
+				"Georgia":                  {long: [3]string{"ジョージア時間", "ジョージア標準時", "ジョージア夏時間"}},
+				"Gilbert_Islands":          {long: [3]string{"", "ギルバート諸島時間", ""}},
+				"Greenland_Eastern":        {long: [3]string{"グリーンランド東部時間", "グリーンランド東部標準時", "グリーンランド東部夏時間"}},
+				"Greenland_Western":        {long: [3]string{"グリーンランド西部時間", "グリーンランド西部標準時", "グリーンランド西部夏時間"}},
+				"Guam":                     {long: [3]string{"", "グアム時間", ""}},
+				"Gulf":                     {long: [3]string{"", "湾岸標準時", ""}},
+				"Guyana":                   {long: [3]string{"", "ガイアナ時間", ""}},
+				"Hawaii_Aleutian":          {long: [3]string{"ハワイ・アリューシャン時間", "ハワイ・アリューシャン標準時", "ハワイ・アリューシャン夏時間"}},
+				"Hong_Kong":                {long: [3]string{"香港時間", "香港標準時", "香港夏時間"}},
+				"Hovd":                     {long: [3]string{"ホブド時間", "ホブド標準時", "ホブド夏時間"}},
+				"India":                    {long: [3]string{"", "インド標準時", ""}},
+				"Indian_Ocean":             {long: [3]string{"", "インド洋時間", ""}},
+				"Indochina":                {long: [3]string{"", "インドシナ時間", ""}},
+				"Indonesia_Central":        {long: [3]string{"", "インドネシア中部時間", ""}},
+				"Indonesia_Eastern":        {long: [3]string{"", "インドネシア東部時間", ""}},
+				"Indonesia_Western":        {long: [3]string{"", "インドネシア西部時間", ""}},
+				"Iran":                     {long: [3]string{"イラン時間", "イラン標準時", "イラン夏時間"}},
+				"Irkutsk":                  {long: [3]string{"イルクーツク時間", "イルクーツク標準時", "イルクーツク夏時間"}},
+				"Israel":                   {long: [3]string{"イスラエル時間", "イスラエル標準時", "イスラエル夏時間"}},
+				"Japan":                    {long: [3]string{"日本時間", "日本標準時", "日本夏時間"}, short: [3]string{"∅∅∅", "JST", "JDT"}},
+				"Kamchatka":                {long: [3]string{"ペトロパブロフスク・カムチャツキー時間", "ペトロパブロフスク・カムチャツキー標準時", "ペトロパブロフスク・カムチャツキー夏時間"}},
+				"Kazakhstan_Eastern":       {long: [3]string{"", "東カザフスタン時間", ""}},
+				"Kazakhstan_Western":       {long: [3]string{"", "西カザフスタン時間", ""}},
+				"Korea":                    {long: [3]string{"韓国時間", "韓国標準時", "韓国夏時間"}},
+				"Kosrae":                   {long: [3]string{"", "コスラエ時間", ""}},
+				"Krasnoyarsk":              {long: [3]string{"クラスノヤルスク時間", "クラスノヤルスク標準時", "クラスノヤルスク夏時間"}},
+				"Kyrgystan":                {long: [3]string{"", "キルギス時間", ""}},
+				"Lanka":                    {long: [3]string{"", "ランカ時間", ""}},
+				"Line_Islands":             {long: [3]string{"", "ライン諸島時間", ""}},
+				"Lord_Howe":                {long: [3]string{"ロードハウ時間", "ロードハウ標準時", "ロードハウ夏時間"}},
+				"Macau":                    {long: [3]string{"マカオ時間", "マカオ標準時", "マカオ夏時間"}},
+				"Macquarie":                {long: [3]string{"", "マッコーリー島時間", ""}},
+				"Magadan":                  {long: [3]string{"マガダン時間", "マガダン標準時", "マガダン夏時間"}},
+				"Malaysia":                 {long: [3]string{"", "マレーシア時間", ""}},
+				"Maldives":                 {long: [3]string{"", "モルディブ時間", ""}},
+				"Marquesas":                {long: [3]string{"", "マルキーズ時間", ""}},
+				"Marshall_Islands":         {long: [3]string{"", "マーシャル諸島時間", ""}},
+				"Mauritius":                {long: [3]string{"モーリシャス時間", "モーリシャス標準時", "モーリシャス夏時間"}},
+				"Mawson":                   {long: [3]string{"", "モーソン基地時間", ""}},
+				"Mexico_Northwest":         {long: [3]string{"メキシコ北西部時間", "メキシコ北西部標準時", "メキシコ北西部夏時間"}},
+				"Mexico_Pacific":           {long: [3]string{"メキシコ太平洋時間", "メキシコ太平洋標準時", "メキシコ太平洋夏時間"}},
+				"Mongolia":                 {long: [3]string{"ウランバートル時間", "ウランバートル標準時", "ウランバートル夏時間"}},
+				"Moscow":                   {long: [3]string{"モスクワ時間", "モスクワ標準時", "モスクワ夏時間"}},
+				"Myanmar":                  {long: [3]string{"", "ミャンマー時間", ""}},
+				"Nauru":                    {long: [3]string{"", "ナウル時間", ""}},
+				"Nepal":                    {long: [3]string{"", "ネパール時間", ""}},
+				"New_Caledonia":            {long: [3]string{"ニューカレドニア時間", "ニューカレドニア標準時", "ニューカレドニア夏時間"}},
+				"New_Zealand":              {long: [3]string{"ニュージーランド時間", "ニュージーランド標準時", "ニュージーランド夏時間"}},
+				"Newfoundland":             {long: [3]string{"ニューファンドランド時間", "ニューファンドランド標準時", "ニューファンドランド夏時間"}},
+				"Niue":                     {long: [3]string{"", "ニウエ時間", ""}},
+				"Norfolk":                  {long: [3]string{"ノーフォーク島時間", "ノーフォーク島標準時", "ノーフォーク島夏時間"}},
+				"Noronha":                  {long: [3]string{"フェルナンド・デ・ノローニャ時間", "フェルナンド・デ・ノローニャ標準時", "フェルナンド・デ・ノローニャ夏時間"}},
+				"North_Mariana":            {long: [3]string{"", "北マリアナ諸島時間", ""}},
+				"Novosibirsk":              {long: [3]string{"ノヴォシビルスク時間", "ノヴォシビルスク標準時", "ノヴォシビルスク夏時間"}},
+				"Omsk":                     {long: [3]string{"オムスク時間", "オムスク標準時", "オムスク夏時間"}},
+				"Pakistan":                 {long: [3]string{"パキスタン時間", "パキスタン標準時", "パキスタン夏時間"}},
+				"Palau":                    {long: [3]string{"", "パラオ時間", ""}},
+				"Papua_New_Guinea":         {long: [3]string{"", "パプアニューギニア時間", ""}},
+				"Paraguay":                 {long: [3]string{"パラグアイ時間", "パラグアイ標準時", "パラグアイ夏時間"}},
+				"Peru":                     {long: [3]string{"ペルー時間", "ペルー標準時", "ペルー夏時間"}},
+				"Philippines":              {long: [3]string{"フィリピン時間", "フィリピン標準時", "フィリピン夏時間"}},
+				"Phoenix_Islands":          {long: [3]string{"", "フェニックス諸島時間", ""}},
+				"Pierre_Miquelon":          {long: [3]string{"サンピエール島・ミクロン島時間", "サンピエール島・ミクロン島標準時", "サンピエール島・ミクロン島夏時間"}},
+				"Pitcairn":                 {long: [3]string{"", "ピトケアン時間", ""}},
+				"Ponape":                   {long: [3]string{"", "ポナペ時間", ""}},
+				"Pyongyang":                {long: [3]string{"", "平壌時間", ""}},
+				"Qyzylorda":                {long: [3]string{"クズロルダ時間", "クズロルダ標準時", "クズロルダ夏時間"}},
+				"Reunion":                  {long: [3]string{"", "レユニオン時間", ""}},
+				"Rothera":                  {long: [3]string{"", "ロゼラ基地時間", ""}},
+				"Sakhalin":                 {long: [3]string{"サハリン時間", "サハリン標準時", "サハリン夏時間"}},
+				"Samara":                   {long: [3]string{"サマラ時間", "サマラ標準時", "サマラ夏時間"}},
+				"Samoa":                    {long: [3]string{"サモア時間", "サモア標準時", "サモア夏時間"}},
+				"Seychelles":               {long: [3]string{"", "セーシェル時間", ""}},
+				"Singapore":                {long: [3]string{"", "シンガポール標準時", ""}},
+				"Solomon":                  {long: [3]string{"", "ソロモン諸島時間", ""}},
+				"South_Georgia":            {long: [3]string{"", "サウスジョージア時間", ""}},
+				"Suriname":                 {long: [3]string{"", "スリナム時間", ""}},
+				"Syowa":                    {long: [3]string{"", "昭和基地時間", ""}},
+				"Tahiti":                   {long: [3]string{"", "タヒチ時間", ""}},
+				"Taipei":                   {long: [3]string{"台北時間", "台北標準時", "台北夏時間"}},
+				"Tajikistan":               {long: [3]string{"", "タジキスタン時間", ""}},
+				"Tokelau":                  {long: [3]string{"", "トケラウ時間", ""}},
+				"Tonga":                    {long: [3]string{"トンガ時間", "トンガ標準時", "トンガ夏時間"}},
+				"Truk":                     {long: [3]string{"", "チューク時間", ""}},
+				"Turkmenistan":             {long: [3]string{"トルクメニスタン時間", "トルクメニスタン標準時", "トルクメニスタン夏時間"}},
+				"Tuvalu":                   {long: [3]string{"", "ツバル時間", ""}},
+				"Uruguay":                  {long: [3]string{"ウルグアイ時間", "ウルグアイ標準時", "ウルグアイ夏時間"}},
+				"Uzbekistan":               {long: [3]string{"ウズベキスタン時間", "ウズベキスタン標準時", "ウズベキスタン夏時間"}},
+				"Vanuatu":                  {long: [3]string{"バヌアツ時間", "バヌアツ標準時", "バヌアツ夏時間"}},
+				"Venezuela":                {long: [3]string{"", "ベネズエラ時間", ""}},
+				"Vladivostok":              {long: [3]string{"ウラジオストク時間", "ウラジオストク標準時", "ウラジオストク夏時間"}},
+				"Volgograd":                {long: [3]string{"ボルゴグラード時間", "ボルゴグラード標準時", "ボルゴグラード夏時間"}},
+				"Vostok":                   {long: [3]string{"", "ボストーク基地時間", ""}},
+				"Wake":                     {long: [3]string{"", "ウェーク島時間", ""}},
+				"Wallis":                   {long: [3]string{"", "ウォリス・フツナ時間", ""}},
+				"Yakutsk":                  {long: [3]string{"ヤクーツク時間", "ヤクーツク標準時", "ヤクーツク夏時間"}},
+				"Yekaterinburg":            {long: [3]string{"エカテリンブルグ時間", "エカテリンブルグ標準時", "エカテリンブルグ夏時間"}},
+				"Yukon":                    {long: [3]string{"", "ユーコン時間", ""}},
+			},
+			zones: map[string]*zoneNameSet{
+				"Etc/UTC":       {long: [3]string{"", "協定世界時", ""}, short: [3]string{"", "UTC", ""}},
+				"Europe/Dublin": {long: [3]string{"", "", "アイルランド標準時"}},
+				"Europe/London": {long: [3]string{"", "", "英国夏時間"}},
+			},
+			cities: map[string]string{
+				"America/Chicago":       "シカゴ",
+				"America/Ciudad_Juarez": "シウダー・フアレス",
+				"America/Denver":        "デンバー",
+				"America/Los_Angeles":   "ロサンゼルス",
+				"America/New_York":      "ニューヨーク",
+				"America/Phoenix":       "フェニックス",
+				"Asia/Kolkata":          "コルカタ",
+				"Asia/Shanghai":         "上海",
+				"Asia/Tokyo":            "東京",
+				"Atlantic/Reykjavik":    "レイキャビク",
+				"Australia/Sydney":      "シドニー",
+				"Europe/Berlin":         "ベルリン",
+				"Europe/London":         "ロンドン",
+				"Europe/Paris":          "パリ",
+				"Pacific/Kanton":        "カントン島",
+			},
+			regions: map[string]string{
+				"AD": "アンドラ",
+				"AE": "アラブ首長国連邦",
+				"AF": "アフガニスタン",
+				"AG": "アンティグア・バーブーダ",
+				"AI": "アンギラ",
+				"AL": "アルバニア",
+				"AM": "アルメニア",
+				"AO": "アンゴラ",
+				"AS": "米領サモア",
+				"AT": "オーストリア",
+				"AW": "アルバ",
+				"AZ": "アゼルバイジャン",
+				"BA": "ボスニア・ヘルツェゴビナ",
+				"BB": "バルバドス",
+				"BD": "バングラデシュ",
+				"BE": "ベルギー",
+				"BF": "ブルキナファソ",
+				"BG": "ブルガリア",
+				"BH": "バーレーン",
+				"BI": "ブルンジ",
+				"BJ": "ベナン",
+				"BM": "バミューダ",
+				"BN": "ブルネイ",
+				"BO": "ボリビア",
+				"BQ": "オランダ領カリブ",
+				"BS": "バハマ",
+				"BT": "ブータン",
+				"BW": "ボツワナ",
+				"BY": "ベラルーシ",
+				"BZ": "ベリーズ",
+				"CC": "ココス(キーリング)諸島",
+				"CF": "中央アフリカ共和国",
+				"CG": "コンゴ共和国(ブラザビル)",
+				"CH": "スイス",
+				"CI": "コートジボワール",
+				"CK": "クック諸島",
+				"CL": "チリ",
+				"CM": "カメルーン",
+				"CN": "中国",
+				"CO": "コロンビア",
+				"CR": "コスタリカ",
+				"CU": "キューバ",
+				"CV": "カーボベルデ",
+				"CX": "クリスマス島",
+				"CZ": "チェコ",
+				"DE": "ドイツ",
+				"DJ": "ジブチ",
+				"DK": "デンマーク",
+				"DM": "ドミニカ国",
+				"DO": "ドミニカ共和国",
+				"DZ": "アルジェリア",
+				"EC": "エクアドル",
+				"EE": "エストニア",
+				"EG": "エジプト",
+				"EH": "西サハラ",
+				"ER": "エリトリア",
+				"ES": "スペイン",
+				"ET": "エチオピア",
+				"FJ": "フィジー",
+				"FK": "フォークランド諸島 (マルビーナス諸島)",
+				"FO": "フェロー諸島",
+				"FR": "フランス",
+				"GA": "ガボン",
+				"GB": "イギリス",
+				"GD": "グレナダ",
+				"GE": "ジョージア",
+				"GF": "仏領ギアナ",
+				"GG": "ガーンジー",
+				"GH": "ガーナ",
+				"GI": "ジブラルタル",
+				"GM": "ガンビア",
+				"GN": "ギニア",
+				"GQ": "赤道ギニア",
+				"GR": "ギリシャ",
+				"GS": "サウスジョージア・サウスサンドウィッチ諸島",
+				"GT": "グアテマラ",
+				"GU": "グアム",
+				"GW": "ギニアビサウ",
+				"GY": "ガイアナ",
+				"HK": "香港",
+				"HN": "ホンジュラス",
+				"HR": "クロアチア",
+				"HT": "ハイチ",
+				"HU": "ハンガリー",
+				"IE": "アイルランド",
+				"IM": "マン島",
+				"IN": "インド",
+				"IO": "英領インド洋地域",
+				"IQ": "イラク",
+				"IR": "イラン",
+				"IS": "アイスランド",
+				"IT": "イタリア",
+				"JE": "ジャージー",
+				"JM": "ジャマイカ",
+				"JO": "ヨルダン",
+				"JP": "日本",
+				"KE": "ケニア",
+				"KG": "キルギス",
+				"KH": "カンボジア",
+				"KM": "コモロ",
+				"KN": "セントクリストファー・ネーヴィス",
+				"KP": "北朝鮮",
+				"KR": "韓国",
+				"KW": "クウェート",
+				"KY": "ケイマン諸島",
+				"LA": "ラオス",
+				"LB": "レバノン",
+				"LC": "セントルシア",
+				"LI": "リヒテンシュタイン",
+				"LK": "スリランカ",
+				"LR": "リベリア",
+				"LS": "レソト",
+				"LT": "リトアニア",
+				"LU": "ルクセンブルク",
+				"LV": "ラトビア",
+				"LY": "リビア",
+				"MA": "モロッコ",
+				"MC": "モナコ",
+				"MD": "モルドバ",
+				"ME": "モンテネグロ",
+				"MG": "マダガスカル",
+				"MH": "マーシャル諸島",
+				"MK": "北マケドニア",
+				"ML": "マリ",
+				"MM": "ミャンマー (ビルマ)",
+				"MO": "マカオ",
+				"MP": "北マリアナ諸島",
+				"MQ": "マルティニーク",
+				"MR": "モーリタニア",
+				"MS": "モントセラト",
+				"MT": "マルタ",
+				"MU": "モーリシャス",
+				"MV": "モルディブ",
+				"MW": "マラウイ",
+				"MY": "マレーシア",
+				"MZ": "モザンビーク",
+				"NA": "ナミビア",
+				"NC": "ニューカレドニア",
+				"NE": "ニジェール",
+				"NF": "ノーフォーク島",
+				"NG": "ナイジェリア",
+				"NI": "ニカラグア",
+				"NL": "オランダ",
+				"NO": "ノルウェー",
+				"NP": "ネパール",
+				"NR": "ナウル",
+				"NU": "ニウエ",
+				"NZ": "ニュージーランド",
+				"OM": "オマーン",
+				"PA": "パナマ",
+				"PE": "ペルー",
+				"PH": "フィリピン",
+				"PK": "パキスタン",
+				"PL": "ポーランド",
+				"PM": "サンピエール島・ミクロン島",
+				"PN": "ピトケアン諸島",
+				"PR": "プエルトリコ",
+				"PT": "ポルトガル",
+				"PW": "パラオ",
+				"PY": "パラグアイ",
+				"QA": "カタール",
+				"RE": "レユニオン",
+				"RO": "ルーマニア",
+				"RS": "セルビア",
+				"RW": "ルワンダ",
+				"SA": "サウジアラビア",
+				"SB": "ソロモン諸島",
+				"SC": "セーシェル",
+				"SD": "スーダン",
+				"SE": "スウェーデン",
+				"SG": "シンガポール",
+				"SH": "セントヘレナ",
+				"SI": "スロベニア",
+				"SJ": "スバールバル諸島・ヤンマイエン島",
+				"SK": "スロバキア",
+				"SL": "シエラレオネ",
+				"SM": "サンマリノ",
+				"SN": "セネガル",
+				"SO": "ソマリア",
+				"SR": "スリナム",
+				"SS": "南スーダン",
+				"ST": "サントメ・プリンシペ",
+				"SV": "エルサルバドル",
+				"SX": "シント・マールテン",
+				"SY": "シリア",
+				"SZ": "エスワティニ",
+				"TC": "タークス・カイコス諸島",
+				"TD": "チャド",
+				"TF": "仏領極南諸島",
+				"TG": "トーゴ",
+				"TH": "タイ",
+				"TJ": "タジキスタン",
+				"TK": "トケラウ",
+				"TL": "東ティモール",
+				"TM": "トルクメニスタン",
+				"TN": "チュニジア",
+				"TO": "トンガ",
+				"TR": "トルコ",
+				"TT": "トリニダード・トバゴ",
+				"TV": "ツバル",
+				"TW": "台湾",
+				"TZ": "タンザニア",
+				"UA": "ウクライナ",
+				"UG": "ウガンダ",
+				"UY": "ウルグアイ",
+				"UZ": "ウズベキスタン",
+				"VA": "バチカン市国",
+				"VC": "セントビンセント及びグレナディーン諸島",
+				"VE": "ベネズエラ",
+				"VG": "英領ヴァージン諸島",
+				"VI": "米領ヴァージン諸島",
+				"VN": "ベトナム",
+				"VU": "バヌアツ",
+				"WF": "ウォリス・フツナ",
+				"WS": "サモア",
+				"YE": "イエメン",
+				"YT": "マヨット",
+				"ZA": "南アフリカ",
+				"ZM": "ザンビア",
+				"ZW": "ジンバブエ",
+			},
+		},
+	})
+}
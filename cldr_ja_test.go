@@ -0,0 +1,8 @@
+//go:build !datefmt_select || datefmt_ja
+// +build !datefmt_select datefmt_ja
+
+package datefmt_test
+
+func init() {
+	generatedLocales["ja"] = true
+}
@@ -0,0 +1,528 @@
+// Code generated by datefmt-cldrgen; DO NOT EDIT.
+
+//go:build !datefmt_select || datefmt_zh
+// +build !datefmt_select datefmt_zh
+
+package datefmt
+
+func init() {
+	registerLocale("zh", &localeData{
+		calendars: map[string]*calendarNames{
+			"gregorian": {
+				eras: [3][]string{
+					{"公元前", "公元"},
+					{"公元前", "公元"},
+					{"公元前", "公元"},
+				},
+				months: [3][]string{
+					{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+					{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+					{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+				},
+				weekdays: [3][]string{
+					{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+					{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+					{"日", "一", "二", "三", "四", "五", "六"},
+				},
+				dayPeriods: [3][]string{
+					{"上午", "下午"},
+					{"上午", "下午"},
+					{"上午", "下午"},
+				},
+			},
+		},
+		skeletons: map[string]string{
+			"Bh":        "Bh时",
+			"Bhm":       "Bh:mm",
+			"Bhms":      "Bh:mm:ss",
+			"E":         "EEE",
+			"EBhm":      "EBh:mm",
+			"EBhms":     "EBh:mm:ss",
+			"EHm":       "EHH:mm",
+			"EHms":      "EHH:mm:ss",
+			"Ed":        "d日E",
+			"Ehm":       "Eah:mm",
+			"Ehms":      "Eah:mm:ss",
+			"Gy":        "Gy年",
+			"GyMMM":     "Gy年M月",
+			"GyMMMEd":   "Gy年M月d日E",
+			"GyMMMd":    "Gy年M月d日",
+			"GyMd":      "Gy/M/d",
+			"H":         "H时",
+			"Hm":        "HH:mm",
+			"Hms":       "HH:mm:ss",
+			"Hmsv":      "v HH:mm:ss",
+			"Hmv":       "v HH:mm",
+			"M":         "M月",
+			"MEEEEd":    "M/dEEEE",
+			"MEd":       "M/dE",
+			"MMM":       "MMM",
+			"MMMEEEEd":  "M月d日EEEE",
+			"MMMEd":     "M月d日E",
+			"MMMMW":     "MMMM第W周",
+			"MMMMd":     "M月d日",
+			"MMMd":      "M月d日",
+			"MMdd":      "MM/dd",
+			"Md":        "M/d",
+			"d":         "d日",
+			"h":         "ah时",
+			"hm":        "ah:mm",
+			"hms":       "ah:mm:ss",
+			"hmsv":      "v ah:mm:ss",
+			"hmv":       "v ah:mm",
+			"ms":        "mm:ss",
+			"y":         "y年",
+			"yM":        "y/M",
+			"yMEEEEd":   "y/M/dEEEE",
+			"yMEd":      "y/M/dE",
+			"yMM":       "y年M月",
+			"yMMM":      "y年M月",
+			"yMMMEEEEd": "y年M月d日EEEE",
+			"yMMMEd":    "y年M月d日E",
+			"yMMMM":     "y年M月",
+			"yMMMd":     "y年M月d日",
+			"yMd":       "y/M/d",
+			"yw":        "Y年第w周",
+		},
+		dateTime: "{1} {0}",
+		hour:     'H',
+		styles: &stylePatterns{
+			date:     [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+			time:     [4]string{"HH:mm", "HH:mm:ss", "z HH:mm:ss", "zzzz HH:mm:ss"},
+			dateTime: [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		},
+		periods: &dayPeriodData{
+			rules: []dayPeriodRule{
+				{id: "midnight", from: 0 * 60, before: 0 * 60},
+				{id: "night1", from: 0 * 60, before: 5 * 60},
+				{id: "morning1", from: 5 * 60, before: 8 * 60},
+				{id: "morning2", from: 8 * 60, before: 12 * 60},
+				{id: "afternoon1", from: 12 * 60, before: 13 * 60},
+				{id: "afternoon2", from: 13 * 60, before: 19 * 60},
+				{id: "evening1", from: 19 * 60, before: 24 * 60},
+			},
+			names: map[string][3]string{
+				"am":         {"上午", "上午", "上午"},
+				"pm":         {"下午", "下午", "下午"},
+				"midnight":   {"午夜", "午夜", "午夜"},
+				"night1":     {"凌晨", "凌晨", "凌晨"},
+				"morning1":   {"早上", "清晨", "早上"},
+				"morning2":   {"上午", "上午", "上午"},
+				"afternoon1": {"中午", "中午", "中午"},
+				"afternoon2": {"下午", "下午", "下午"},
+				"evening1":   {"晚上", "晚上", "晚上"},
+			},
+		},
+		zones: &zoneNames{
+			gmtFormat:     "GMT{0}",
+			gmtZeroFormat: "GMT",
+			hourFormat:    "+HH:mm;-HH:mm",
+			regionFormat:  "{0}时间",
+			metazones: map[string]*zoneNameSet{
+				"Acre":                     {long: [3]string{"阿克里时间", "阿克里标准时间", "阿克里夏令时间"}},
+				"Afghanistan":              {long: [3]string{"", "阿富汗时间", ""}},
+				"Africa_Central":           {long: [3]string{"", "中部非洲时间", ""}},
+				"Africa_Eastern":           {long: [3]string{"", "东部非洲时间", ""}},
+				"Africa_Southern":          {long: [3]string{"", "南非标准时间", ""}},
+				"Africa_Western":           {long: [3]string{"西部非洲时间", "西部非洲标准时间", "西部非洲夏令时间"}},
+				"Alaska":                   {long: [3]string{"阿拉斯加时间", "阿拉斯加标准时间", "阿拉斯加夏令时间"}},
+				"Almaty":                   {long: [3]string{"阿拉木图时间", "阿拉木图标准时间", "阿拉木图夏令时间"}},
+				"Amazon":                   {long: [3]string{"亚马逊时间", "亚马逊标准时间", "亚马逊夏令时间"}},
+				"America_Central":          {long: [3]string{"北美中部时间", "北美中部标准时间", "北美中部夏令时间"}},
+				"America_Eastern":          {long: [3]string{"北美东部时间", "北美东部标准时间", "北美东部夏令时间"}},
+				"America_Mountain":         {long: [3]string{"北美山区时间", "北美山区标准时间", "北美山区夏令时间"}},
+				"America_Pacific":          {long: [3]string{"北美太平洋时间", "北美太平洋标准时间", "北美太平洋夏令时间"}},
+				"Anadyr":                   {long: [3]string{"阿纳德尔时间", "阿纳德尔标准时间", "阿纳德尔夏令时间"}},
+				"Apia":                     {long: [3]string{"阿皮亚时间", "阿皮亚标准时间", "阿皮亚夏令时间"}},
+				"Aqtau":                    {long: [3]string{"阿克套时间", "阿克套标准时间", "阿克套夏令时间"}},
+				"Aqtobe":                   {long: [3]string{"阿克托别时间", "阿克托别标准时间", "阿克托别夏令时间"}},
+				"Arabian":                  {long: [3]string{"阿拉伯时间", "阿拉伯标准时间", "阿拉伯夏令时间"}},
+				"Argentina":                {long: [3]string{"阿根廷时间", "阿根廷标准时间", "阿根廷夏令时间"}},
+				"Argentina_Western":        {long: [3]string{"阿根廷西部时间", "阿根廷西部标准时间", "阿根廷西部夏令时间"}},
+				"Armenia":                  {long: [3]string{"亚美尼亚时间", "亚美尼亚标准时间", "亚美尼亚夏令时间"}},
+				"Atlantic":                 {long: [3]string{"大西洋时间", "大西洋标准时间", "大西洋夏令时间"}},
+				"Australia_Central":        {long: [3]string{"澳大利亚中部时间", "澳大利亚中部标准时间", "澳大利亚中部夏令时间"}},
+				"Australia_CentralWestern": {long: [3]string{"澳大利亚中西部时间", "澳大利亚中西部标准时间", "澳大利亚中西部夏令时间"}},
+				"Australia_Eastern":        {long: [3]string{"澳大利亚东部时间", "澳大利亚东部标准时间", "澳大利亚东部夏令时间"}},
+				"Australia_Western":        {long: [3]string{"澳大利亚西部时间", "澳大利亚西部标准时间", "澳大利亚西部夏令时间"}},
+				"Azerbaijan":               {long: [3]string{"阿塞拜疆时间", "阿塞拜疆标准时间", "阿塞拜疆夏令时间"}},
+				"Azores":                   {long: [3]string{"亚速尔群岛时间", "亚速尔群岛标准时间", "亚速尔群岛夏令时间"}},
+				"Bangladesh":               {long: [3]string{"孟加拉时间", "孟加拉标准时间", "孟加拉夏令时间"}},
+				"Bhutan":                   {long: [3]string{"", "不丹时间", ""}},
+				"Bolivia":                  {long: [3]string{"", "玻利维亚标准时间", ""}},
+				"Brasilia":                 {long: [3]string{"巴西利亚时间", "巴西利亚标准时间", "巴西利亚夏令时间"}},
+				"Brunei":                   {long: [3]string{"", "文莱达鲁萨兰时间", ""}},
+				"Cape_Verde":               {long: [3]string{"佛得角时间", "佛得角标准时间", "佛得角夏令时间"}},
+				"Casey":                    {long: [3]string{"", "凯西时间", ""}},
+				"Chamorro":                 {long: [3]string{"", "查莫罗时间", ""}},
+				"Chatham":                  {long: [3]string{"查塔姆时间", "查塔姆标准时间", "查塔姆夏令时间"}},
+				"Chile":                    {long: [3]string{"智利时间", "智利标准时间", "智利夏令时间"}},
+				"China":                    {long: [3]string{"中国时间", "中国标准时间", "中国夏令时间"}},
+				"Choibalsan":               {long: [3]string{"乔巴山时间", "乔巴山标准时间", "乔巴山夏令时间"}},
+				"Christmas":                {long: [3]string{"", "圣诞岛时间", ""}},
+				"Cocos":                    {long: [3]string{"", "科科斯群岛时间", ""}},
+				"Colombia":                 {long: [3]string{"哥伦比亚时间", "哥伦比亚标准时间", "哥伦比亚夏令时间"}},
+				"Cook":                     {long: [3]string{"库克群岛时间", "库克群岛标准时间", "库克群岛仲夏时间"}},
+				"Cuba":                     {long: [3]string{"古巴时间", "古巴标准时间", "古巴夏令时间"}},
+				"Davis":                    {long: [3]string{"", "戴维斯时间", ""}},
+				"DumontDUrville":           {long: [3]string{"", "迪蒙·迪维尔时间", ""}},
+				"East_Timor":               {long: [3]string{"", "东帝汶时间", ""}},
+				"Easter":                   {long: [3]string{"复活节岛时间", "复活节岛标准时间", "复活节岛夏令时间"}},
+				"Ecuador":                  {long: [3]string{"", "厄瓜多尔标准时间", ""}},
+				"Europe_Central":           {long: [3]string{"中欧时间", "中欧标准时间", "中欧夏令时间"}},
+				"Europe_Eastern":           {long: [3]string{"东欧时间", "东欧标准时间", "东欧夏令时间"}},
+				"Europe_Further_Eastern":   {long: [3]string{"", "欧洲极东时间", ""}},
+				"Europe_Western":           {long: [3]string{"西欧时间", "西欧标准时间", "西欧夏令时间"}},
+				"Falkland":                 {long: [3]string{"福克兰群岛时间", "福克兰群岛标准时间", "福克兰群岛夏令时间"}},
+				"Fiji":                     {long: [3]string{"斐济时间", "斐济标准时间", "斐济夏令时间"}},
+				"French_Guiana":            {long: [3]string{"", "法属圭亚那标准时间", ""}},
+				"French_Southern":          {long: [3]string{"", "法属南方和南极领地时间", ""}},
+				"GMT":                      {long: [3]string{"", "格林尼治标准时间", ""}},
+				"Galapagos":                {long: [3]string{"", "加拉帕戈斯时间", ""}},
+				"Gambier":                  {long: [3]string{"", "甘比尔时间", ""}},
+				"Georgia":                  {long: [3]string{"格鲁吉亚时间", "格鲁吉亚标准时间", "格鲁吉亚夏令时间"}},
+				"Gilbert_Islands":          {long: [3]string{"", "吉尔伯特群岛时间", ""}},
+				"Greenland_Eastern":        {long: [3]string{"格陵兰岛东部时间", "格陵兰岛东部标准时间", "格陵兰岛东部夏令时间"}},
+				"Greenland_Western":        {long: [3]string{"格陵兰岛西部时间", "格陵兰岛西部标准时间", "格陵兰岛西部夏令时间"}},
+				"Guam":                     {long: [3]string{"", "关岛时间", ""}},
+				"Gulf":                     {long: [3]string{"", "海湾标准时间", ""}},
+				"Guyana":                   {long: [3]string{"", "圭亚那时间", ""}},
+				"Hawaii_Aleutian":          {long: [3]string{"夏威夷-阿留申时间", "夏威夷-阿留申标准时间", "夏威夷-阿留申夏令时间"}},
+				"Hong_Kong":                {long: [3]string{"香港时间", "香港标准时间", "香港夏令时间"}},
+				"Hovd":                     {long: [3]string{"科布多时间", "科布多标准时间", "科布多夏令时间"}},
+				"India":                    {long: [3]string{"", "印度时间", ""}},
+				"Indian_Ocean":             {long: [3]string{"", "印度洋时间", ""}},
+				"Indochina":                {long: [3]string{"", "中南半岛时间", ""}},
+				"Indonesia_Central":        {long: [3]string{"", "印度尼西亚中部时间", ""}},
+				"Indonesia_Eastern":        {long: [3]string{"", "印度尼西亚东部时间", ""}},
+				"Indonesia_Western":        {long: [3]string{"", "印度尼西亚西部时间", ""}},
+				"Iran":                     {long: [3]string{"伊朗时间", "伊朗标准时间", "伊朗夏令时间"}},
+				"Irkutsk":                  {long: [3]string{"伊尔库茨克时间", "伊尔库茨克标准时间", "伊尔库茨克夏令时间"}},
+				"Israel":                   {long: [3]string{"以色列时间", "以色列标准时间", "以色列夏令时间"}},
+				"Japan":                    {long: [3]string{"日本时间", "日本标准时间", "日本夏令时间"}},
+				"Kamchatka":                {long: [3]string{"彼得罗巴甫洛夫斯克-堪察加时间", "彼得罗巴甫洛夫斯克-堪察加标准时间", "彼得罗巴甫洛夫斯克-堪察加夏令时间"}},
+				"Kazakhstan_Eastern":       {long: [3]string{"", "哈萨克斯坦东部时间", ""}},
+				"Kazakhstan_Western":       {long: [3]string{"", "哈萨克斯坦西部时间", ""}},
+				"Korea":                    {long: [3]string{"韩国时间", "韩国标准时间", "韩国夏令时间"}},
+				"Kosrae":                   {long: [3]string{"", "科斯雷时间", ""}},
+				"Krasnoyarsk":              {long: [3]string{"克拉斯诺亚尔斯克时间", "克拉斯诺亚尔斯克标准时间", "克拉斯诺亚尔斯克夏令时间"}},
+				"Kyrgystan":                {long: [3]string{"", "吉尔吉斯斯坦时间", ""}},
+				"Lanka":                    {long: [3]string{"", "兰卡时间", ""}},
+				"Line_Islands":             {long: [3]string{"", "莱恩群岛时间", ""}},
+				"Lord_Howe":                {long: [3]string{"豪勋爵岛时间", "豪勋爵岛标准时间", "豪勋爵岛夏令时间"}},
+				"Macau":                    {long: [3]string{"澳门时间", "澳门标准时间", "澳门夏令时间"}},
+				"Macquarie":                {long: [3]string{"", "麦夸里岛时间", ""}},
+				"Magadan":                  {long: [3]string{"马加丹时间", "马加丹标准时间", "马加丹夏令时间"}},
+				"Malaysia":                 {long: [3]string{"", "马来西亚时间", ""}},
+				"Maldives":                 {long: [3]string{"", "马尔代夫时间", ""}},
+				"Marquesas":                {long: [3]string{"", "马克萨斯群岛时间", ""}},
+				"Marshall_Islands":         {long: [3]string{"", "马绍尔群岛时间", ""}},
+				"Mauritius":                {long: [3]string{"毛里求斯时间", "毛里求斯标准时间", "毛里求斯夏令时间"}},
+				"Mawson":                   {long: [3]string{"", "莫森时间", ""}},
+				"Mexico_Northwest":         {long: [3]string{"墨西哥西北部时间", "墨西哥西北部标准时间", "墨西哥西北部夏令时间"}},
+				"Mexico_Pacific":           {long: [3]string{"墨西哥太平洋时间", "墨西哥太平洋标准时间", "墨西哥太平洋夏令时间"}},
+				"Mongolia":                 {long: [3]string{"乌兰巴托时间", "乌兰巴托标准时间", "乌兰巴托夏令时间"}},
+				"Moscow":                   {long: [3]string{"莫斯科时间", "莫斯科标准时间", "莫斯科夏令时间"}},
+				"Myanmar":                  {long: [3]string{"", "缅甸时间", ""}},
+				"Nauru":                    {long: [3]string{"", "瑙鲁时间", ""}},
+				"Nepal":                    {long: [3]string{"", "尼泊尔时间", ""}},
+				"New_Caledonia":            {long: [3]string{"新喀里多尼亚时间", "新喀里多尼亚标准时间", "新喀里多尼亚夏令时间"}},
+				"New_Zealand":              {long: [3]string{"新西兰时间", "新西兰标准时间", "新西兰夏令时间"}},
+				"Newfoundland":             {long: [3]string{"纽芬兰时间", "纽芬兰标准时间", "纽芬兰夏令时间"}},
+				"Niue":                     {long: [3]string{"", "纽埃时间", ""}},
+				"Norfolk":                  {long: [3]string{"诺福克岛时间", "诺福克岛标准时间", "诺福克岛夏令时间"}},
+				"Noronha":                  {long: [3]string{"费尔南多-迪诺罗尼亚岛时间", "费尔南多-迪诺罗尼亚岛标准时间", "费尔南多-迪诺罗尼亚岛夏令时间"}},
+				"North_Mariana":            {long: [3]string{"", "北马里亚纳群岛时间", ""}},
+				"Novosibirsk":              {long: [3]string{"新西伯利亚时间", "新西伯利亚标准时间", "新西伯利亚夏令时间"}},
+				"Omsk":                     {long: [3]string{"鄂木斯克时间", "鄂木斯克标准时间", "鄂木斯克夏令时间"}},
+				"Pakistan":                 {long: [3]string{"巴基斯坦时间", "巴基斯坦标准时间", "巴基斯坦夏令时间"}},
+				"Palau":                    {long: [3]string{"", "帕劳时间", ""}},
+				"Papua_New_Guinea":         {long: [3]string{"", "巴布亚新几内亚时间", ""}},
+				"Paraguay":                 {long: [3]string{"巴拉圭时间", "巴拉圭标准时间", "巴拉圭夏令时间"}},
+				"Peru":                     {long: [3]string{"秘鲁时间", "秘鲁标准时间", "秘鲁夏令时间"}},
+				"Philippines":              {long: [3]string{"菲律宾时间", "菲律宾标准时间", "菲律宾夏令时间"}},
+				"Phoenix_Islands":          {long: [3]string{"", "菲尼克斯群岛时间", ""}},
+				"Pierre_Miquelon":          {long: [3]string{"圣皮埃尔和密克隆群岛时间", "圣皮埃尔和密克隆群岛标准时间", "圣皮埃尔和密克隆群岛夏令时间"}},
+				"Pitcairn":                 {long: [3]string{"", "皮特凯恩时间", ""}},
+				"Ponape":                   {long: [3]string{"", "波纳佩时间", ""}},
+				"Pyongyang":                {long: [3]string{"", "平壤时间", ""}},
+				"Qyzylorda":                {long: [3]string{"克孜洛尔达时间", "克孜洛尔达标准时间", "克孜洛尔达夏令时间"}},
+				"Reunion":                  {long: [3]string{"", "留尼汪时间", ""}},
+				"Rothera":                  {long: [3]string{"", "罗瑟拉时间", ""}},
+				"Sakhalin":                 {long: [3]string{"库页岛时间", "库页岛标准时间", "库页岛夏令时间"}},
+				"Samara":                   {long: [3]string{"萨马拉时间", "萨马拉标准时间", "萨马拉夏令时间"}},
+				"Samoa":                    {long: [3]string{"萨摩亚时间", "萨摩亚标准时间", "萨摩亚夏令时间"}},
+				"Seychelles":               {long: [3]string{"", "塞舌尔时间", ""}},
+				"Singapore":                {long: [3]string{"", "新加坡标准时间", ""}},
+				"Solomon":                  {long: [3]string{"", "所罗门群岛时间", ""}},
+				"South_Georgia":            {long: [3]string{"", "南乔治亚岛时间", ""}},
+				"Suriname":                 {long: [3]string{"", "苏里南时间", ""}},
+				"Syowa":                    {long: [3]string{"", "昭和时间", ""}},
+				"Tahiti":                   {long: [3]string{"", "塔希提岛时间", ""}},
+				"Taipei":                   {long: [3]string{"台北时间", "台北标准时间", "台北夏令时间"}},
+				"Tajikistan":               {long: [3]string{"", "塔吉克斯坦时间", ""}},
+				"Tokelau":                  {long: [3]string{"", "托克劳时间", ""}},
+				"Tonga":                    {long: [3]string{"汤加时间", "汤加标准时间", "汤加夏令时间"}},
+				"Truk":                     {long: [3]string{"", "楚克时间", ""}},
+				"Turkmenistan":             {long: [3]string{"土库曼斯坦时间", "土库曼斯坦标准时间", "土库曼斯坦夏令时间"}},
+				"Tuvalu":                   {long: [3]string{"", "图瓦卢时间", ""}},
+				"Uruguay":                  {long: [3]string{"乌拉圭时间", "乌拉圭标准时间", "乌拉圭夏令时间"}},
+				"Uzbekistan":               {long: [3]string{"乌兹别克斯坦时间", "乌兹别克斯坦标准时间", "乌兹别克斯坦夏令时间"}},
+				"Vanuatu":                  {long: [3]string{"瓦努阿图时间", "瓦努阿图标准时间", "瓦努阿图夏令时间"}},
+				"Venezuela":                {long: [3]string{"", "委内瑞拉时间", ""}},
+				"Vladivostok":              {long: [3]string{"海参崴时间", "海参崴标准时间", "海参崴夏令时间"}},
+				"Volgograd":                {long: [3]string{"伏尔加格勒时间", "伏尔加格勒标准时间", "伏尔加格勒夏令时间"}},
+				"Vostok":                   {long: [3]string{"", "沃斯托克时间", ""}},
+				"Wake":                     {long: [3]string{"", "威克岛时间", ""}},
+				"Wallis":                   {long: [3]string{"", "瓦利斯和富图纳时间", ""}},
+				"Yakutsk":                  {long: [3]string{"雅库茨克时间", "雅库茨克标准时间", "雅库茨克夏令时间"}},
+				"Yekaterinburg":            {long: [3]string{"叶卡捷琳堡时间", "叶卡捷琳堡标准时间", "叶卡捷琳堡夏令时间"}},
+				"Yukon":                    {long: [3]string{"", "育空时间", ""}},
+			},
+			zones: map[string]*zoneNameSet{
+				"Etc/UTC":       {long: [3]string{"", "协调世界时", ""}, short: [3]string{"", "UTC", ""}},
+				"Europe/Dublin": {long: [3]string{"", "", "爱尔兰标准时间"}},
+				"Europe/London": {long: [3]string{"", "", "英国夏令时间"}},
+			},
+			cities: map[string]string{
+				"America/Chicago":       "芝加哥",
+				"America/Ciudad_Juarez": "华雷斯城",
+				"America/Denver":        "丹佛",
+				"America/Los_Angeles":   "洛杉矶",
+				"America/New_York":      "纽约",
+				"America/Phoenix":       "凤凰城",
+				"Asia/Kolkata":          "加尔各答",
+				"Asia/Shanghai":         "上海",
+				"Asia/Tokyo":            "东京",
+				"Atlantic/Reykjavik":    "雷克雅未克",
+				"Australia/Sydney":      "悉尼",
+				"Europe/Berlin":         "柏林",
+				"Europe/London":         "伦敦",
+				"Europe/Paris":          "巴黎",
+				"Pacific/Kanton":        "坎顿岛",
+			},
+			regions: map[string]string{
+				"AD": "安道尔",
+				"AE": "阿拉伯联合酋长国",
+				"AF": "阿富汗",
+				"AG": "安提瓜和巴布达",
+				"AI": "安圭拉",
+				"AL": "阿尔巴尼亚",
+				"AM": "亚美尼亚",
+				"AO": "安哥拉",
+				"AS": "美属萨摩亚",
+				"AT": "奥地利",
+				"AW": "阿鲁巴",
+				"AZ": "阿塞拜疆",
+				"BA": "波斯尼亚和黑塞哥维那",
+				"BB": "巴巴多斯",
+				"BD": "孟加拉国",
+				"BE": "比利时",
+				"BF": "布基纳法索",
+				"BG": "保加利亚",
+				"BH": "巴林",
+				"BI": "布隆迪",
+				"BJ": "贝宁",
+				"BM": "百慕大",
+				"BN": "文莱",
+				"BO": "玻利维亚",
+				"BQ": "荷属加勒比区",
+				"BS": "巴哈马",
+				"BT": "不丹",
+				"BW": "博茨瓦纳",
+				"BY": "白俄罗斯",
+				"BZ": "伯利兹",
+				"CC": "科科斯（基林）群岛",
+				"CF": "中非共和国",
+				"CG": "刚果（布）",
+				"CH": "瑞士",
+				"CI": "科特迪瓦",
+				"CK": "库克群岛",
+				"CL": "智利",
+				"CM": "喀麦隆",
+				"CN": "中国",
+				"CO": "哥伦比亚",
+				"CR": "哥斯达黎加",
+				"CU": "古巴",
+				"CV": "佛得角",
+				"CX": "圣诞岛",
+				"CZ": "捷克",
+				"DE": "德国",
+				"DJ": "吉布提",
+				"DK": "丹麦",
+				"DM": "多米尼克",
+				"DO": "多米尼加共和国",
+				"DZ": "阿尔及利亚",
+				"EC": "厄瓜多尔",
+				"EE": "爱沙尼亚",
+				"EG": "埃及",
+				"EH": "西撒哈拉",
+				"ER": "厄立特里亚",
+				"ES": "西班牙",
+				"ET": "埃塞俄比亚",
+				"FJ": "斐济",
+				"FK": "福克兰群岛（马尔维纳斯群岛）",
+				"FO": "法罗群岛",
+				"FR": "法国",
+				"GA": "加蓬",
+				"GB": "英国",
+				"GD": "格林纳达",
+				"GE": "格鲁吉亚",
+				"GF": "法属圭亚那",
+				"GG": "根西岛",
+				"GH": "加纳",
+				"GI": "直布罗陀",
+				"GM": "冈比亚",
+				"GN": "几内亚",
+				"GQ": "赤道几内亚",
+				"GR": "希腊",
+				"GS": "南乔治亚和南桑威奇群岛",
+				"GT": "危地马拉",
+				"GU": "关岛",
+				"GW": "几内亚比绍",
+				"GY": "圭亚那",
+				"HK": "香港",
+				"HN": "洪都拉斯",
+				"HR": "克罗地亚",
+				"HT": "海地",
+				"HU": "匈牙利",
+				"IE": "爱尔兰",
+				"IM": "马恩岛",
+				"IN": "印度",
+				"IO": "英属印度洋领地",
+				"IQ": "伊拉克",
+				"IR": "伊朗",
+				"IS": "冰岛",
+				"IT": "意大利",
+				"JE": "泽西岛",
+				"JM": "牙买加",
+				"JO": "约旦",
+				"JP": "日本",
+				"KE": "肯尼亚",
+				"KG": "吉尔吉斯斯坦",
+				"KH": "柬埔寨",
+				"KM": "科摩罗",
+				"KN": "圣基茨和尼维斯",
+				"KP": "朝鲜",
+				"KR": "韩国",
+				"KW": "科威特",
+				"KY": "开曼群岛",
+				"LA": "老挝",
+				"LB": "黎巴嫩",
+				"LC": "圣卢西亚",
+				"LI": "列支敦士登",
+				"LK": "斯里兰卡",
+				"LR": "利比里亚",
+				"LS": "莱索托",
+				"LT": "立陶宛",
+				"LU": "卢森堡",
+				"LV": "拉脱维亚",
+				"LY": "利比亚",
+				"MA": "摩洛哥",
+				"MC": "摩纳哥",
+				"MD": "摩尔多瓦",
+				"ME": "黑山",
+				"MG": "马达加斯加",
+				"MH": "马绍尔群岛",
+				"MK": "北马其顿",
+				"ML": "马里",
+				"MM": "缅甸",
+				"MO": "澳门",
+				"MP": "北马里亚纳群岛",
+				"MQ": "马提尼克",
+				"MR": "毛里塔尼亚",
+				"MS": "蒙特塞拉特",
+				"MT": "马耳他",
+				"MU": "毛里求斯",
+				"MV": "马尔代夫",
+				"MW": "马拉维",
+				"MY": "马来西亚",
+				"MZ": "莫桑比克",
+				"NA": "纳米比亚",
+				"NC": "新喀里多尼亚",
+				"NE": "尼日尔",
+				"NF": "诺福克岛",
+				"NG": "尼日利亚",
+				"NI": "尼加拉瓜",
+				"NL": "荷兰",
+				"NO": "挪威",
+				"NP": "尼泊尔",
+				"NR": "瑙鲁",
+				"NU": "纽埃",
+				"NZ": "新西兰",
+				"OM": "阿曼",
+				"PA": "巴拿马",
+				"PE": "秘鲁",
+				"PH": "菲律宾",
+				"PK": "巴基斯坦",
+				"PL": "波兰",
+				"PM": "圣皮埃尔和密克隆群岛",
+				"PN": "皮特凯恩群岛",
+				"PR": "波多黎各",
+				"PT": "葡萄牙",
+				"PW": "帕劳",
+				"PY": "巴拉圭",
+				"QA": "卡塔尔",
+				"RE": "留尼汪",
+				"RO": "罗马尼亚",
+				"RS": "塞尔维亚",
+				"RW": "卢旺达",
+				"SA": "沙特阿拉伯",
+				"SB": "所罗门群岛",
+				"SC": "塞舌尔",
+				"SD": "苏丹",
+				"SE": "瑞典",
+				"SG": "新加坡",
+				"SH": "圣赫勒拿",
+				"SI": "斯洛文尼亚",
+				"SJ": "斯瓦尔巴和扬马延",
+				"SK": "斯洛伐克",
+				"SL": "塞拉利昂",
+				"SM": "圣马力诺",
+				"SN": "塞内加尔",
+				"SO": "索马里",
+				"SR": "苏里南",
+				"SS": "南苏丹",
+				"ST": "圣多美和普林西比",
+				"SV": "萨尔瓦多",
+				"SX": "荷属圣马丁",
+				"SY": "叙利亚",
+				"SZ": "斯威士兰",
+				"TC": "特克斯和凯科斯群岛",
+				"TD": "乍得",
+				"TF": "法属南部领地",
+				"TG": "多哥",
+				"TH": "泰国",
+				"TJ": "塔吉克斯坦",
+				"TK": "托克劳",
+				"TL": "东帝汶",
+				"TM": "土库曼斯坦",
+				"TN": "突尼斯",
+				"TO": "汤加",
+				"TR": "土耳其",
+				"TT": "特立尼达和多巴哥",
+				"TV": "图瓦卢",
+				"TW": "台湾",
+				"TZ": "坦桑尼亚",
+				"UA": "乌克兰",
+				"UG": "乌干达",
+				"UY": "乌拉圭",
+				"UZ": "乌兹别克斯坦",
+				"VA": "梵蒂冈",
+				"VC": "圣文森特和格林纳丁斯",
+				"VE": "委内瑞拉",
+				"VG": "英属维尔京群岛",
+				"VI": "美属维尔京群岛",
+				"VN": "越南",
+				"VU": "瓦努阿图",
+				"WF": "瓦利斯和富图纳",
+				"WS": "萨摩亚",
+				"YE": "也门",
+				"YT": "马约特",
+				"ZA": "南非",
+				"ZM": "赞比亚",
+				"ZW": "津巴布韦",
+			},
+		},
+	})
+}
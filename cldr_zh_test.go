@@ -0,0 +1,38 @@
+//go:build !datefmt_select || datefmt_zh
+// +build !datefmt_select datefmt_zh
+
+package datefmt_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func init() {
+	generatedLocales["zh"] = true
+}
+
+func ExampleFormatRange_zh() {
+	start := time.Date(2022, time.June, 20, 9, 0, 0, 0, time.UTC)
+	s, _ := datefmt.FormatRange(start, start.AddDate(0, 0, 5), "yMMMd", "zh")
+	fmt.Println(s)
+	// Output:
+	// 2022年6月20日 – 25日
+}
+
+func ExampleBestPattern_zh() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	l, _ := datefmt.BestPattern("yMMMd", "zh")
+	fmt.Println(l, "->", l.Format(t))
+	// Output:
+	// y年M月d日 -> 2022年6月20日
+}
+
+func ExampleStyleLayout_zh() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.StyleLayout(datefmt.StyleShort, datefmt.StyleNone, "zh-CN").Format(t))
+	// Output:
+	// 2022/6/20
+}
@@ -0,0 +1,850 @@
+// Command datefmt-cldrgen generates the locale tables of datefmt from a CLDR JSON snapshot.
+//
+// Usage:
+//
+//	datefmt-cldrgen -cldr testdata/cldr -locales ja,zh -builtin en -out .
+//
+// It reads main/<locale>/ca-gregorian.json and supplemental/dayPeriods.json of the
+// snapshot, which is in the layout of cldr-json, and writes cldr_<locale>.go with
+// the names of the months, weekdays, eras and day periods, the rules of the day
+// periods, the standard patterns and the skeletons of the locale. The names of
+// time zones are read from main/<locale>/timeZoneNames.json and territories.json
+// if present.
+//
+// The built-in locale, which is English, is not registered but written to
+// cldr_builtin.go, which has the rules and names of its day periods, the names
+// of time zones, and the time zones with their metazones and aliases read from
+// bcp47/timezone.json, supplemental/metaZones.json and supplemental/primaryZones.json.
+// Patterns with letters which are not supported by datefmt are left out.
+//
+// All the generated locales are built by default. Build with the tag datefmt_select
+// and a tag datefmt_<locale> for each locale to include only the selected locales,
+// e.g. -tags datefmt_select,datefmt_zh.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	var (
+		cldr    = flag.String("cldr", "testdata/cldr", "directory of the CLDR JSON snapshot")
+		locales = flag.String("locales", "", "comma separated locales to generate")
+		builtin = flag.String("builtin", "", "the built-in locale, whose day periods and time zones are written to "+builtinFile)
+		out     = flag.String("out", ".", "output directory")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("datefmt-cldrgen: ")
+
+	for _, loc := range strings.Split(*locales, ",") {
+		if loc = strings.TrimSpace(loc); loc == "" {
+			continue
+		}
+		src, err := generate(*cldr, loc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*out, fileName(loc)), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *builtin != "" {
+		src, err := generateBuiltin(*cldr, *builtin)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(*out, builtinFile), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// builtinFile is the name of the generated file of the built-in locale.
+const builtinFile = "cldr_builtin.go"
+
+// fileName returns the name of the generated file of the locale.
+func fileName(loc string) string {
+	return "cldr_" + tagName(loc) + ".go"
+}
+
+// tagName returns the locale in the name of files and build tags, e.g. zh_hant for zh-Hant.
+func tagName(loc string) string {
+	return strings.ToLower(strings.Replace(loc, "-", "_", -1))
+}
+
+type cldrFile struct {
+	Main map[string]struct {
+		Dates struct {
+			Calendars struct {
+				Gregorian cldrCalendar `json:"gregorian"`
+			} `json:"calendars"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+// cldrNames is the names by context, width and key.
+type cldrNames map[string]map[string]map[string]string
+
+type cldrCalendar struct {
+	Months          cldrNames                    `json:"months"`
+	Days            cldrNames                    `json:"days"`
+	DayPeriods      cldrNames                    `json:"dayPeriods"`
+	Eras            map[string]map[string]string `json:"eras"`
+	DateFormats     map[string]string            `json:"dateFormats"`
+	TimeFormats     map[string]string            `json:"timeFormats"`
+	DateTimeFormats map[string]json.RawMessage   `json:"dateTimeFormats"`
+}
+
+var (
+	// widths is the CLDR widths by datefmt.NameWidth.
+	widths = []string{"abbreviated", "wide", "narrow"}
+	// eraWidths is the CLDR era widths by datefmt.NameWidth.
+	eraWidths = []string{"eraAbbr", "eraNames", "eraNarrow"}
+	// styles is the CLDR styles by datefmt.Style, from StyleShort to StyleFull.
+	styles = []string{"short", "medium", "long", "full"}
+
+	monthKeys     = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	weekdayKeys   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+	dayPeriodKeys = []string{"am", "pm"}
+	eraKeys       = []string{"0", "1"}
+)
+
+// supportedLetters is the pattern letters supported by datefmt.
+const supportedLetters = "GyYrUMwWDdFEuabBHkKhmsSzZXvOV"
+
+// readCalendar reads the Gregorian calendar of the locale.
+func readCalendar(dir, loc string) (*cldrCalendar, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "main", loc, "ca-gregorian.json"))
+	if err != nil {
+		return nil, err
+	}
+	var f cldrFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %v", loc, err)
+	}
+	main, ok := f.Main[loc]
+	if !ok {
+		return nil, fmt.Errorf("%s: no data of the locale", loc)
+	}
+	return &main.Dates.Calendars.Gregorian, nil
+}
+
+// generateBuiltin returns the Go source of the day periods of the built-in locale.
+func generateBuiltin(dir, loc string) ([]byte, error) {
+	cal, err := readCalendar(dir, loc)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := dayPeriodRules(dir, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%s: no day periods", loc)
+	}
+	tz, err := readTimezones(dir)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by datefmt-cldrgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package datefmt\n\n")
+	fmt.Fprintf(&b, "// dayPeriodsEN is the built-in English day periods.\n")
+	fmt.Fprintf(&b, "var dayPeriodsEN = ")
+	writeDayPeriods(&b, rules, cal.DayPeriods["format"])
+	fmt.Fprintf(&b, "\n\n")
+	tz.write(&b)
+	fmt.Fprintf(&b, "// zoneNamesEN is the built-in English names of time zones.\n")
+	fmt.Fprintf(&b, "var zoneNamesEN = ")
+	ok, err := writeZoneNames(&b, dir, loc, tz)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", loc, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s: no names of time zones", loc)
+	}
+	fmt.Fprintf(&b, "\n")
+	return format.Source(b.Bytes())
+}
+
+// generate returns the Go source of the locale.
+func generate(dir, loc string) ([]byte, error) {
+	cal, err := readCalendar(dir, loc)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := dayPeriodRules(dir, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by datefmt-cldrgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "//go:build !datefmt_select || datefmt_%s\n", tagName(loc))
+	fmt.Fprintf(&b, "// +build !datefmt_select datefmt_%s\n\n", tagName(loc))
+	fmt.Fprintf(&b, "package datefmt\n\n")
+	fmt.Fprintf(&b, "func init() {\n")
+	fmt.Fprintf(&b, "registerLocale(%q, &localeData{\n", loc)
+
+	fmt.Fprintf(&b, "calendars: map[string]*calendarNames{\n\"gregorian\": {\n")
+	eras := make([][]string, len(eraWidths))
+	for i, w := range eraWidths {
+		eras[i] = lookup(cal.Eras[w], eraKeys)
+	}
+	writeNames(&b, "eras", eras)
+	writeNames(&b, "months", cal.Months.names("format", monthKeys))
+	writeNames(&b, "weekdays", cal.Days.names("format", weekdayKeys))
+	writeNames(&b, "dayPeriods", cal.DayPeriods.names("format", dayPeriodKeys))
+	fmt.Fprintf(&b, "},\n},\n")
+
+	skeletons, err := cal.skeletons()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", loc, err)
+	}
+	fmt.Fprintf(&b, "skeletons: map[string]string{\n")
+	keys := make([]string, 0, len(skeletons))
+	for key := range skeletons {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%q: %q,\n", key, skeletons[key])
+	}
+	fmt.Fprintf(&b, "},\n")
+
+	var dateFormats, timeFormats, dateTimeFormats []string
+	for _, style := range styles {
+		date, _ := convertPattern(cal.DateFormats[style])
+		time, _ := convertPattern(cal.TimeFormats[style])
+		var dateTime string
+		if err := json.Unmarshal(cal.DateTimeFormats[style], &dateTime); err != nil {
+			return nil, fmt.Errorf("%s: dateTimeFormats %s: %v", loc, style, err)
+		}
+		dateFormats = append(dateFormats, date)
+		timeFormats = append(timeFormats, time)
+		dateTimeFormats = append(dateTimeFormats, dateTime)
+	}
+	fmt.Fprintf(&b, "dateTime: %q,\n", dateTimeFormats[1])
+	if hour := hourLetter(timeFormats[0]); hour != 0 {
+		fmt.Fprintf(&b, "hour: %q,\n", hour)
+	}
+	fmt.Fprintf(&b, "styles: &stylePatterns{\n")
+	fmt.Fprintf(&b, "date: [4]string{%s},\n", quoteList(dateFormats))
+	fmt.Fprintf(&b, "time: [4]string{%s},\n", quoteList(timeFormats))
+	fmt.Fprintf(&b, "dateTime: [4]string{%s},\n", quoteList(dateTimeFormats))
+	fmt.Fprintf(&b, "},\n")
+
+	if len(rules) > 0 {
+		fmt.Fprintf(&b, "periods: ")
+		writeDayPeriods(&b, rules, cal.DayPeriods["format"])
+		fmt.Fprintf(&b, ",\n")
+	}
+	tz, err := readTimezones(dir)
+	if err != nil {
+		return nil, err
+	}
+	var zones bytes.Buffer
+	ok, err := writeZoneNames(&zones, dir, loc, tz)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", loc, err)
+	}
+	if ok {
+		fmt.Fprintf(&b, "zones: %s,\n", zones.Bytes())
+	}
+
+	fmt.Fprintf(&b, "})\n}\n")
+	return format.Source(b.Bytes())
+}
+
+// dayPeriodRule is a rule of supplemental/dayPeriods.json.
+type dayPeriodRule struct {
+	id           string
+	from, before int // minutes of the day
+}
+
+// dayPeriodRules returns the rules of the day periods of the language of the locale.
+func dayPeriodRules(dir, loc string) ([]dayPeriodRule, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "supplemental", "dayPeriods.json"))
+	if err != nil {
+		return nil, err
+	}
+	var f struct {
+		Supplemental struct {
+			DayPeriodRuleSet map[string]map[string]struct {
+				At     string `json:"_at"`
+				From   string `json:"_from"`
+				Before string `json:"_before"`
+			} `json:"dayPeriodRuleSet"`
+		} `json:"supplemental"`
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("dayPeriods: %v", err)
+	}
+	lang := strings.SplitN(loc, "-", 2)[0]
+	var rules []dayPeriodRule
+	for id, r := range f.Supplemental.DayPeriodRuleSet[lang] {
+		from, before := r.From, r.Before
+		if r.At != "" {
+			from, before = r.At, r.At
+		}
+		rule := dayPeriodRule{id: id}
+		if rule.from, err = minuteOfDay(from); err != nil {
+			return nil, fmt.Errorf("dayPeriods %s %s: %v", lang, id, err)
+		}
+		if rule.before, err = minuteOfDay(before); err != nil {
+			return nil, fmt.Errorf("dayPeriods %s %s: %v", lang, id, err)
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].from != rules[j].from {
+			return rules[i].from < rules[j].from
+		}
+		return rules[i].id < rules[j].id
+	})
+	return rules, nil
+}
+
+// minuteOfDay returns the minute of the day of hh:mm.
+func minuteOfDay(s string) (int, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(s, "%d:%d", &hour, &minute); err != nil {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	return hour*60 + minute, nil
+}
+
+// writeDayPeriods writes the day periods as a *dayPeriodData expression.
+func writeDayPeriods(b *bytes.Buffer, rules []dayPeriodRule, names map[string]map[string]string) {
+	fmt.Fprintf(b, "&dayPeriodData{\nrules: []dayPeriodRule{\n")
+	for _, r := range rules {
+		fmt.Fprintf(b, "{id: %q, from: %s, before: %s},\n", r.id, minuteExpr(r.from), minuteExpr(r.before))
+	}
+	fmt.Fprintf(b, "},\nnames: map[string][3]string{\n")
+	ids := []string{"am", "pm"}
+	for _, r := range rules {
+		ids = append(ids, r.id)
+	}
+	for _, id := range ids {
+		fmt.Fprintf(b, "%q: {%s},\n", id, quoteList(lookup(map[string]string{
+			"abbreviated": names["abbreviated"][id],
+			"wide":        names["wide"][id],
+			"narrow":      names["narrow"][id],
+		}, widths)))
+	}
+	fmt.Fprintf(b, "},\n}")
+}
+
+// minuteExpr returns the minute of the day as an expression in hours, e.g. 12 * 60.
+func minuteExpr(minute int) string {
+	if minute%60 == 0 {
+		return fmt.Sprintf("%d * 60", minute/60)
+	}
+	return fmt.Sprintf("%d*60 + %d", minute/60, minute%60)
+}
+
+// timezones is the locale independent data of time zones, read from
+// bcp47/timezone.json, supplemental/metaZones.json and supplemental/primaryZones.json.
+type timezones struct {
+	zones   map[string]timezone // by IANA ID
+	aliases map[string]string   // IANA IDs by alias, including CLDR IDs
+	golden  map[string]string   // the zones of metazones in the world
+}
+
+// timezone is the data of a time zone.
+type timezone struct {
+	metazone, short, region string
+}
+
+// readTimezones reads the time zones of the snapshot.
+func readTimezones(dir string) (*timezones, error) {
+	tz := &timezones{zones: map[string]timezone{}, aliases: map[string]string{}, golden: map[string]string{}}
+	var bcp47 struct {
+		Keyword struct {
+			U struct {
+				TZ map[string]json.RawMessage `json:"tz"`
+			} `json:"u"`
+		} `json:"keyword"`
+	}
+	if err := readJSON(filepath.Join(dir, "bcp47", "timezone.json"), &bcp47); err != nil {
+		return nil, err
+	}
+	for short, raw := range bcp47.Keyword.U.TZ {
+		if strings.HasPrefix(short, "_") {
+			continue
+		}
+		var t struct {
+			Alias string `json:"_alias"`
+			IANA  string `json:"_iana"`
+		}
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return nil, fmt.Errorf("timezone %s: %v", short, err)
+		}
+		ids := strings.Fields(t.Alias)
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("timezone %s: no ID", short)
+		}
+		// the first alias is the CLDR ID, which is an old IANA ID for some zones
+		id := ids[0]
+		if t.IANA != "" {
+			id = t.IANA
+		}
+		for _, alias := range ids {
+			if alias != id {
+				tz.aliases[alias] = id
+			}
+		}
+		tz.zones[id] = timezone{short: short}
+	}
+
+	var metaZones struct {
+		Supplemental struct {
+			MetaZones struct {
+				MetazoneInfo struct {
+					Timezone map[string]json.RawMessage `json:"timezone"`
+				} `json:"metazoneInfo"`
+				Metazones []struct {
+					MapZone struct {
+						Other     string `json:"_other"`
+						Territory string `json:"_territory"`
+						Type      string `json:"_type"`
+					} `json:"mapZone"`
+				} `json:"metazones"`
+			} `json:"metaZones"`
+		} `json:"supplemental"`
+	}
+	if err := readJSON(filepath.Join(dir, "supplemental", "metaZones.json"), &metaZones); err != nil {
+		return nil, err
+	}
+	if err := tz.readMetazones("", metaZones.Supplemental.MetaZones.MetazoneInfo.Timezone); err != nil {
+		return nil, err
+	}
+	for _, m := range metaZones.Supplemental.MetaZones.Metazones {
+		if m.MapZone.Territory == "001" {
+			tz.golden[m.MapZone.Other] = tz.canonical(m.MapZone.Type)
+		}
+	}
+
+	var primaryZones struct {
+		Supplemental struct {
+			PrimaryZones map[string]string `json:"primaryZones"`
+		} `json:"supplemental"`
+	}
+	if err := readJSON(filepath.Join(dir, "supplemental", "primaryZones.json"), &primaryZones); err != nil {
+		return nil, err
+	}
+	// the location names of zones are the names of their regions if the region
+	// has only the zone or the zone is the primary zone of the region
+	count := map[string]int{}
+	for _, zone := range tz.zones {
+		count[zone.country()]++
+	}
+	for id, zone := range tz.zones {
+		if country := zone.country(); country != "" && count[country] == 1 {
+			zone.region = country
+			tz.zones[id] = zone
+		}
+	}
+	for region, id := range primaryZones.Supplemental.PrimaryZones {
+		zone := tz.zones[tz.canonical(id)]
+		zone.region = region
+		tz.zones[tz.canonical(id)] = zone
+	}
+	return tz, nil
+}
+
+// readMetazones reads the current metazones of the zones in the tree of the zone
+// IDs, e.g. America/Los_Angeles.
+func (tz *timezones) readMetazones(prefix string, tree map[string]json.RawMessage) error {
+	for key, raw := range tree {
+		var uses []struct {
+			UsesMetazone struct {
+				Mzone string `json:"_mzone"`
+				To    string `json:"_to"`
+			} `json:"usesMetazone"`
+		}
+		if err := json.Unmarshal(raw, &uses); err != nil {
+			var node map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &node); err != nil {
+				return fmt.Errorf("metazoneInfo %s%s: %v", prefix, key, err)
+			}
+			if err := tz.readMetazones(prefix+key+"/", node); err != nil {
+				return err
+			}
+			continue
+		}
+		id := tz.canonical(prefix + key)
+		zone, ok := tz.zones[id]
+		if !ok {
+			return fmt.Errorf("metazoneInfo %s: unknown zone", id)
+		}
+		for _, use := range uses {
+			if use.UsesMetazone.To == "" {
+				zone.metazone = use.UsesMetazone.Mzone
+			}
+		}
+		tz.zones[id] = zone
+	}
+	return nil
+}
+
+// canonical returns the IANA ID of the zone.
+func (tz *timezones) canonical(id string) string {
+	if canonical, ok := tz.aliases[id]; ok {
+		return canonical
+	}
+	return id
+}
+
+// country returns the country of the zone by its short ID, e.g. US for uslax.
+func (zone timezone) country() string {
+	if len(zone.short) != 5 {
+		// e.g. utc or est5edt
+		return ""
+	}
+	return strings.ToUpper(zone.short[:2])
+}
+
+// write writes the tables of the time zones.
+func (tz *timezones) write(b *bytes.Buffer) {
+	fmt.Fprintf(b, "// zoneInfos is the data of the time zones by ID.\n")
+	fmt.Fprintf(b, "var zoneInfos = map[string]zoneInfo{\n")
+	ids := make([]string, 0, len(tz.zones))
+	for id := range tz.zones {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		zone := tz.zones[id]
+		var fields []string
+		if zone.metazone != "" {
+			fields = append(fields, fmt.Sprintf("metazone: %q", zone.metazone))
+		}
+		fields = append(fields, fmt.Sprintf("short: %q", zone.short))
+		if zone.region != "" {
+			fields = append(fields, fmt.Sprintf("region: %q", zone.region))
+		}
+		fmt.Fprintf(b, "%q: {%s},\n", id, strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(b, "}\n\n")
+	fmt.Fprintf(b, "// zoneAliases is the canonical IDs of aliases of time zones.\n")
+	fmt.Fprintf(b, "var zoneAliases = ")
+	writeStringMap(b, "", tz.aliases)
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "// metazoneZones is the zone of a metazone in the world, e.g. for parsing Pacific Time.\n")
+	fmt.Fprintf(b, "var metazoneZones = ")
+	writeStringMap(b, "", tz.golden)
+	fmt.Fprintf(b, "\n")
+}
+
+// readJSON reads the JSON file into v.
+func readJSON(name string, v interface{}) error {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %v", filepath.Base(name), err)
+	}
+	return nil
+}
+
+// cldrZoneFile is main/<locale>/timeZoneNames.json.
+type cldrZoneFile struct {
+	Main map[string]struct {
+		Dates struct {
+			TimeZoneNames struct {
+				HourFormat    string                     `json:"hourFormat"`
+				GMTFormat     string                     `json:"gmtFormat"`
+				GMTZeroFormat string                     `json:"gmtZeroFormat"`
+				RegionFormat  string                     `json:"regionFormat"`
+				Zone          map[string]json.RawMessage `json:"zone"`
+				Metazone      map[string]cldrZone        `json:"metazone"`
+			} `json:"timeZoneNames"`
+		} `json:"dates"`
+	} `json:"main"`
+}
+
+// cldrTerritoryFile is main/<locale>/territories.json.
+type cldrTerritoryFile struct {
+	Main map[string]struct {
+		LocaleDisplayNames struct {
+			Territories map[string]string `json:"territories"`
+		} `json:"localeDisplayNames"`
+	} `json:"main"`
+}
+
+// cldrZone is the names of a time zone or a metazone.
+type cldrZone struct {
+	ExemplarCity string            `json:"exemplarCity"`
+	Long         map[string]string `json:"long"`
+	Short        map[string]string `json:"short"`
+}
+
+// zoneKinds is the CLDR kinds of names in the order of zoneNameSet.
+var zoneKinds = []string{"generic", "standard", "daylight"}
+
+// readZones collects the zones in the tree of the zone IDs, e.g. America/Los_Angeles.
+func readZones(prefix string, tree map[string]json.RawMessage, zones map[string]cldrZone) error {
+	for key, raw := range tree {
+		var node map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &node); err != nil {
+			return fmt.Errorf("zone %s%s: %v", prefix, key, err)
+		}
+		_, city := node["exemplarCity"]
+		_, long := node["long"]
+		_, short := node["short"]
+		if !city && !long && !short {
+			if err := readZones(prefix+key+"/", node, zones); err != nil {
+				return err
+			}
+			continue
+		}
+		var zone cldrZone
+		if err := json.Unmarshal(raw, &zone); err != nil {
+			return fmt.Errorf("zone %s%s: %v", prefix, key, err)
+		}
+		zones[prefix+key] = zone
+	}
+	return nil
+}
+
+// writeZoneNames writes the names of time zones of the locale as a *zoneNames
+// expression, and reports whether the snapshot has them.
+func writeZoneNames(b *bytes.Buffer, dir, loc string, tz *timezones) (bool, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "main", loc, "timeZoneNames.json"))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var f cldrZoneFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return false, err
+	}
+	names := f.Main[loc].Dates.TimeZoneNames
+	cldrZones := map[string]cldrZone{}
+	if err := readZones("", names.Zone, cldrZones); err != nil {
+		return false, err
+	}
+	// the names in the snapshot are by CLDR ID, e.g. Asia/Calcutta for Asia/Kolkata
+	zones := map[string]cldrZone{}
+	for id, zone := range cldrZones {
+		zones[tz.canonical(id)] = zone
+	}
+	var regions map[string]string
+	data, err = ioutil.ReadFile(filepath.Join(dir, "main", loc, "territories.json"))
+	switch {
+	case err == nil:
+		var t cldrTerritoryFile
+		if err := json.Unmarshal(data, &t); err != nil {
+			return false, err
+		}
+		// only the regions of the location names of zones are used
+		regions = map[string]string{}
+		for _, info := range tz.zones {
+			if name, ok := t.Main[loc].LocaleDisplayNames.Territories[info.region]; ok {
+				regions[info.region] = name
+			}
+		}
+	case !os.IsNotExist(err):
+		return false, err
+	}
+
+	fmt.Fprintf(b, "&zoneNames{\n")
+	fmt.Fprintf(b, "gmtFormat: %q,\n", names.GMTFormat)
+	fmt.Fprintf(b, "gmtZeroFormat: %q,\n", names.GMTZeroFormat)
+	fmt.Fprintf(b, "hourFormat: %q,\n", names.HourFormat)
+	fmt.Fprintf(b, "regionFormat: %q,\n", names.RegionFormat)
+	writeZoneNameSets(b, "metazones", names.Metazone)
+	writeZoneNameSets(b, "zones", zones)
+	cities := map[string]string{}
+	for id, zone := range zones {
+		if zone.ExemplarCity != "" {
+			cities[id] = zone.ExemplarCity
+		}
+	}
+	writeStringMap(b, "cities", cities)
+	writeStringMap(b, "regions", regions)
+	fmt.Fprintf(b, "}")
+	return true, nil
+}
+
+func writeZoneNameSets(b *bytes.Buffer, field string, zones map[string]cldrZone) {
+	var ids []string
+	for id, zone := range zones {
+		if len(zone.Long) > 0 || len(zone.Short) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	sort.Strings(ids)
+	fmt.Fprintf(b, "%s: map[string]*zoneNameSet{\n", field)
+	for _, id := range ids {
+		var sets []string
+		if long := zones[id].Long; len(long) > 0 {
+			sets = append(sets, "long: [3]string{"+quoteList(lookup(long, zoneKinds))+"}")
+		}
+		if short := zones[id].Short; len(short) > 0 {
+			sets = append(sets, "short: [3]string{"+quoteList(lookup(short, zoneKinds))+"}")
+		}
+		fmt.Fprintf(b, "%q: {%s},\n", id, strings.Join(sets, ", "))
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+// writeStringMap writes the map as a field, or as an expression if the field is empty.
+func writeStringMap(b *bytes.Buffer, field string, m map[string]string) {
+	if len(m) == 0 && field != "" {
+		return
+	}
+	if field != "" {
+		fmt.Fprintf(b, "%s: ", field)
+	}
+	fmt.Fprintf(b, "map[string]string{\n")
+	for _, key := range sortedKeys(m) {
+		fmt.Fprintf(b, "%q: %q,\n", key, m[key])
+	}
+	if field != "" {
+		fmt.Fprintf(b, "},\n")
+	} else {
+		fmt.Fprintf(b, "}\n")
+	}
+}
+
+// sortedKeys returns the sorted keys of the map.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// names returns the names of the context by width.
+func (n cldrNames) names(context string, keys []string) [][]string {
+	names := make([][]string, len(widths))
+	for i, w := range widths {
+		names[i] = lookup(n[context][w], keys)
+	}
+	return names
+}
+
+func lookup(m map[string]string, keys []string) []string {
+	if m == nil {
+		return nil
+	}
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = m[key]
+	}
+	return names
+}
+
+// skeletons returns the available formats which are supported by datefmt.
+func (c *cldrCalendar) skeletons() (map[string]string, error) {
+	var available map[string]string
+	if err := json.Unmarshal(c.DateTimeFormats["availableFormats"], &available); err != nil {
+		return nil, fmt.Errorf("availableFormats: %v", err)
+	}
+	skeletons := map[string]string{}
+	for key, pattern := range available {
+		if i := strings.Index(key, "-count-"); i >= 0 {
+			// plural forms of the same pattern
+			if !strings.HasSuffix(key, "-count-other") {
+				continue
+			}
+			key = key[:i]
+		}
+		if strings.Contains(key, "-alt-") || !supported(key) {
+			continue
+		}
+		if pattern, ok := convertPattern(pattern); ok {
+			skeletons[key] = pattern
+		}
+	}
+	return skeletons, nil
+}
+
+// supported reports whether all letters of the skeleton are supported.
+func supported(skeleton string) bool {
+	for i := 0; i < len(skeleton); i++ {
+		if c := skeleton[i]; c != 'L' && c != 'c' && c != 'j' && !strings.ContainsRune(supportedLetters, rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// convertPattern converts the stand-alone letters of the pattern to the letters of
+// datefmt, and reports whether all letters of the pattern are supported.
+func convertPattern(pattern string) (string, bool) {
+	b := []byte(pattern)
+	quoted := false
+	for i, c := range b {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case quoted || !isLetter(c):
+		case c == 'L':
+			b[i] = 'M'
+		case c == 'c':
+			b[i] = 'E'
+		case !strings.ContainsRune(supportedLetters, rune(c)):
+			return pattern, false
+		}
+	}
+	return string(b), true
+}
+
+// hourLetter returns the first hour letter of the pattern.
+func hourLetter(pattern string) byte {
+	quoted := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\'':
+			quoted = !quoted
+		case !quoted && strings.IndexByte("hHkK", c) >= 0:
+			return c
+		}
+	}
+	return 0
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func writeNames(b *bytes.Buffer, field string, names [][]string) {
+	if len(names) == 0 || names[0] == nil {
+		return
+	}
+	fmt.Fprintf(b, "%s: [3][]string{\n", field)
+	for _, n := range names {
+		fmt.Fprintf(b, "{%s},\n", quoteList(n))
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func quoteList(list []string) string {
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerate checks that the generated files in the repository are up to date.
+func TestGenerate(t *testing.T) {
+	for _, loc := range []string{"ja", "zh"} {
+		src, err := generate(filepath.Join("..", "..", "testdata", "cldr"), loc)
+		if err != nil {
+			t.Fatalf("generate(%q) error: %v", loc, err)
+		}
+		want, err := ioutil.ReadFile(filepath.Join("..", "..", fileName(loc)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(src, want) {
+			t.Errorf("%s is out of date, run go generate", fileName(loc))
+		}
+	}
+	src, err := generateBuiltin(filepath.Join("..", "..", "testdata", "cldr"), "en")
+	if err != nil {
+		t.Fatalf("generateBuiltin error: %v", err)
+	}
+	want, err := ioutil.ReadFile(filepath.Join("..", "..", builtinFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, want) {
+		t.Errorf("%s is out of date, run go generate", builtinFile)
+	}
+}
+
+func TestConvertPattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		out     string
+		ok      bool
+	}{
+		{pattern: "LLL", out: "MMM", ok: true},
+		{pattern: "ccc, d", out: "EEE, d", ok: true},
+		{pattern: "h 'o''clock' a", out: "h 'o''clock' a", ok: true},
+		{pattern: "y年M月d日EEEE", out: "y年M月d日EEEE", ok: true},
+		{pattern: "h:mm B", out: "h:mm B", ok: true},
+		{pattern: "h:mm v", out: "h:mm v", ok: true},
+		{pattern: "h:mm:ss.SSS xxx", ok: false},
+		{pattern: "QQQ y", ok: false},
+	}
+	for _, c := range testCases {
+		out, ok := convertPattern(c.pattern)
+		if ok != c.ok || (ok && out != c.out) {
+			t.Errorf("convertPattern(%q) = %q, %v, want %q, %v", c.pattern, out, ok, c.out, c.ok)
+		}
+	}
+	if got := hourLetter("'h' H:mm"); got != 'H' {
+		t.Errorf("hourLetter = %q, want 'H'", got)
+	}
+}
@@ -60,6 +60,57 @@ var (
 				},
 			},
 		},
+		{
+			layout: "yyyy-MM-dd",
+			testCases: []testCase{
+				{
+					in:  time.Date(-1, time.March, 4, 0, 0, 0, 0, time.UTC),
+					out: "-0001-03-04",
+				},
+			},
+		},
+		{
+			layout: "G y yyyy r rrrr",
+			testCases: []testCase{
+				{
+					in:  time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+					out: "AD 2022 2022 2022 2022",
+				},
+				{
+					in:  time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+					out: "AD 1 0001 1 0001",
+				},
+				{
+					in:  time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC),
+					out: "BC 1 0001 0 0000",
+				},
+				{
+					in:  time.Date(-1, time.January, 1, 0, 0, 0, 0, time.UTC),
+					out: "BC 2 0002 -1 -0001",
+				},
+			},
+		},
+		{
+			layout: "yyyy-MM-dd rrrr-MM-dd rrrrrr-MM-dd",
+			testCases: []testCase{
+				{
+					in:  time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC),
+					out: "2022-06-20 2022-06-20 +002022-06-20",
+				},
+				{
+					in:  time.Date(12345, time.June, 20, 0, 0, 0, 0, time.UTC),
+					out: "12345-06-20 +12345-06-20 +012345-06-20",
+				},
+				{
+					in:  time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC),
+					out: "-0043-03-15 -0043-03-15 -000043-03-15",
+				},
+				{
+					in:  time.Date(292277026596, time.December, 4, 0, 0, 0, 0, time.UTC),
+					out: "292277026596-12-04 +292277026596-12-04 +292277026596-12-04",
+				},
+			},
+		},
 		{
 			layout: "y yy yyy yyyy yyyyy Y YY YYY YYYY YYYYY",
 			testCases: []testCase{
@@ -348,6 +399,7 @@ func TestFormat(t *testing.T) {
 
 func TestFormatStable(t *testing.T) {
 	for _, tt := range formatTestCases {
+		tt := tt
 		t.Run(tt.layout, func(t *testing.T) {
 			t.Parallel()
 			for _, c := range tt.testCases {
@@ -0,0 +1,204 @@
+package datefmt
+
+import (
+	"strconv"
+	"time"
+)
+
+// Day periods are the am/pm markers with noon and midnight, which is b, and the
+// flexible day periods like "in the morning", which is B.
+
+// dayPeriodRule is a day period from a minute of the day before another one,
+// which wraps around midnight if before is less than from.
+type dayPeriodRule struct {
+	id           string // e.g. morning1, the CLDR identifier of the period
+	from, before int    // minutes of the day, equal for a point of time like noon
+}
+
+func (r *dayPeriodRule) point() bool {
+	return r.from == r.before
+}
+
+func (r *dayPeriodRule) contains(minute int) bool {
+	switch {
+	case r.point():
+		return minute == r.from
+	case r.from < r.before:
+		return r.from <= minute && minute < r.before
+	}
+	return minute >= r.from || minute < r.before
+}
+
+// distance returns the minutes between the minute of the day and the nearest
+// minute of the period, which is zero if the period contains it.
+func (r *dayPeriodRule) distance(minute int) int {
+	if r.contains(minute) {
+		return 0
+	}
+	last := r.before - 1
+	if r.point() {
+		last = r.from
+	}
+	return minInt((r.from-minute+24*60)%(24*60), (minute-last+24*60)%(24*60))
+}
+
+// dayPeriodData is the day periods of a locale.
+type dayPeriodData struct {
+	rules []dayPeriodRule      // the flexible day periods, noon and midnight
+	names map[string][3]string // short, long and narrow names by the id, including am and pm
+}
+
+// DayPeriod is a named period of the day for the flexible day period B, from
+// Start since midnight until End. The period wraps around midnight if End is
+// before Start, and is a point of time like noon if End equals Start.
+type DayPeriod struct {
+	Name       string
+	Start, End time.Duration
+}
+
+// newDayPeriodData returns the day periods, with the am/pm markers of base.
+func newDayPeriodData(periods []DayPeriod, base *dayPeriodData) *dayPeriodData {
+	data := &dayPeriodData{names: map[string][3]string{
+		"am": base.names["am"],
+		"pm": base.names["pm"],
+	}}
+	for i, p := range periods {
+		id := strconv.Itoa(i)
+		data.rules = append(data.rules, dayPeriodRule{
+			id:     id,
+			from:   int(p.Start/time.Minute) % (24 * 60),
+			before: int(p.End/time.Minute) % (24 * 60),
+		})
+		data.names[id] = [3]string{p.Name, p.Name, p.Name}
+	}
+	return data
+}
+
+func (loc Locale) dayPeriods() *dayPeriodData {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.periods != nil {
+			return data.periods
+		}
+	}
+	return dayPeriodsEN
+}
+
+// lookup returns the id of the day period of the minute of the day. The flexible
+// day periods don't include midnight, which would be ambiguous, like ICU.
+func (d *dayPeriodData) lookup(minute int, flexible bool) string {
+	// points of time first
+	for i := range d.rules {
+		r := &d.rules[i]
+		if !r.point() || !r.contains(minute) || flexible && r.id == "midnight" {
+			continue
+		}
+		if _, ok := d.names[r.id]; ok {
+			return r.id
+		}
+	}
+	if flexible {
+		for i := range d.rules {
+			if r := &d.rules[i]; !r.point() && r.contains(minute) {
+				return r.id
+			}
+		}
+	}
+	if minute >= 12*60 {
+		return "pm"
+	}
+	return "am"
+}
+
+func (d *dayPeriodData) format(p []byte, minute, w int, flexible bool) []byte {
+	return append(p, d.names[d.lookup(minute, flexible)][eraNameWidth(w)]...)
+}
+
+func (d *dayPeriodData) max(w int) int {
+	n := 0
+	for _, names := range d.names {
+		n = maxInt(n, len(names[eraNameWidth(w)]))
+	}
+	return n
+}
+
+func (d *dayPeriodData) parse(ps *parser, value string) (string, error) {
+	var (
+		id     string
+		length int
+		ids    = []string{"am", "pm"}
+	)
+	for _, r := range d.rules {
+		ids = append(ids, r.id)
+	}
+	// the longest name of any width, am and pm first for the same names, e.g. 上午 in Chinese
+	for _, i := range ids {
+		names := d.names[i]
+		if _, rest, ok := lookupName(value, names[:]); ok && len(value)-len(rest) > length {
+			id, length = i, len(value)-len(rest)
+		}
+	}
+	switch id {
+	case "":
+		return value, errBad
+	case "am", "pm":
+		ps.f.PM = id == "pm"
+		ps.f.present |= FieldPM
+		return value[length:], nil
+	}
+	for i := range d.rules {
+		if d.rules[i].id == id {
+			ps.period = &d.rules[i]
+		}
+	}
+	return value[length:], nil
+}
+
+// resolveDayPeriod sets the am/pm marker of a 12-hour clock from the parsed day
+// period, or the time if the period is a point of time and there is no hour.
+func (ps *parser) resolveDayPeriod() {
+	r := ps.period
+	if r == nil {
+		return
+	}
+	if !ps.f.Has(FieldHour) {
+		if r.point() {
+			ps.f.Hour, ps.f.Minute = r.from/60, r.from%60
+			ps.f.present |= FieldHour | FieldMinute
+		}
+		return
+	}
+	if !ps.hour12 || ps.f.Has(FieldPM) {
+		return
+	}
+	// the reading of the 12-hour clock in or nearest to the period, e.g. 中午1:00
+	// is 13:00 though 中午 is from 12:00 before 13:00 in Chinese
+	minute := ps.f.Hour*60 + ps.f.Minute
+	ps.f.PM = r.distance(minute+12*60) < r.distance(minute)
+	ps.f.present |= FieldPM
+}
+
+// b B Day period
+
+func formatDayPeriod(p []byte, v, w int) []byte {
+	return dayPeriodsEN.format(p, v, w, false)
+}
+
+func formatFlexibleDayPeriod(p []byte, v, w int) []byte {
+	return dayPeriodsEN.format(p, v, w, true)
+}
+
+func parseDayPeriod(ps *parser, value string, _ int) (string, error) {
+	return dayPeriodsEN.parse(ps, value)
+}
+
+// localizeDayPeriodArg replaces the English day periods in arg with data.
+func localizeDayPeriodArg(arg *formatArg, data *dayPeriodData) {
+	flexible := arg.s[0] == 'B'
+	arg.max = data.max(arg.w)
+	arg.ph.format = func(p []byte, v, w int) []byte {
+		return data.format(p, v, w, flexible)
+	}
+	arg.ph.parse = func(ps *parser, value string, _ int) (string, error) {
+		return data.parse(ps, value)
+	}
+}
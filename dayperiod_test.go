@@ -0,0 +1,131 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleWithDayPeriods() {
+	t := time.Date(2022, time.June, 20, 12, 30, 0, 0, time.UTC)
+	fmt.Println(datefmt.NewLayout("h:mm B").Format(t))
+	fmt.Println(datefmt.NewLayout("h:mm B", datefmt.WithDayPeriods(
+		datefmt.DayPeriod{Name: "at lunch", Start: 12 * time.Hour, End: 14 * time.Hour},
+	)).Format(t))
+	// Output:
+	// 12:30 in the afternoon
+	// 12:30 at lunch
+}
+
+func TestDayPeriod(t *testing.T) {
+	times := [][2]int{{0, 0}, {3, 0}, {9, 30}, {12, 0}, {12, 30}, {15, 0}, {19, 0}, {22, 0}, {23, 30}}
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		out    string
+	}{
+		{layout: "h:mm B", out: "12:00 at night | 3:00 at night | 9:30 in the morning | 12:00 noon | 12:30 in the afternoon | 3:00 in the afternoon | 7:00 in the evening | 10:00 at night | 11:30 at night"},
+		{layout: "h:mm b", out: "12:00 midnight | 3:00 AM | 9:30 AM | 12:00 noon | 12:30 PM | 3:00 PM | 7:00 PM | 10:00 PM | 11:30 PM"},
+		{layout: "h:mm bbbbb", out: "12:00 mi | 3:00 a | 9:30 a | 12:00 n | 12:30 p | 3:00 p | 7:00 p | 10:00 p | 11:30 p"},
+		{layout: "Bh:mm", locale: "zh", out: "凌晨12:00 | 凌晨3:00 | 上午9:30 | 中午12:00 | 中午12:30 | 下午3:00 | 晚上7:00 | 晚上10:00 | 晚上11:30"},
+		{layout: "bh:mm", locale: "zh-CN", out: "午夜12:00 | 上午3:00 | 上午9:30 | 下午12:00 | 下午12:30 | 下午3:00 | 下午7:00 | 下午10:00 | 下午11:30"},
+		{layout: "Bh:mm", locale: "ja", out: "夜中12:00 | 夜中3:00 | 朝9:30 | 正午12:00 | 昼12:30 | 昼3:00 | 夜7:00 | 夜10:00 | 夜中11:30"},
+		{layout: "BK:mm", locale: "ja-JP", out: "夜中0:00 | 夜中3:00 | 朝9:30 | 正午0:00 | 昼0:30 | 昼3:00 | 夜7:00 | 夜10:00 | 夜中11:30"},
+	}
+	for _, c := range testCases {
+		if !builtLocale(c.locale) {
+			continue
+		}
+		l := datefmt.NewLayout(c.layout, datefmt.WithLocale(c.locale))
+		var out []string
+		for _, hm := range times {
+			tm := time.Date(2022, 6, 20, hm[0], hm[1], 0, 0, time.UTC)
+			s := l.Format(tm)
+			out = append(out, s)
+			parsed, err := l.ParseWithDefaults(s, tm)
+			if err != nil {
+				t.Errorf("Parse(%q, %q) error: %v", c.layout, s, err)
+			} else if !parsed.Equal(tm) {
+				t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, s, parsed, tm)
+			}
+		}
+		if s := strings.Join(out, " | "); s != c.out {
+			t.Errorf("Format(%q, %q) = %q, want %q", c.layout, c.locale, s, c.out)
+		}
+	}
+}
+
+func TestDayPeriodParse(t *testing.T) {
+	ref := time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		value  string
+		hour   int
+		minute int
+	}{
+		{layout: "h B", value: "10 at night", hour: 22},
+		{layout: "h B", value: "10 in the morning", hour: 10},
+		{layout: "h B", value: "3 at night", hour: 3},
+		{layout: "h:mm B", value: "3:00 at night", hour: 3},
+		{layout: "h:mm B", value: "12:15 in the afternoon", hour: 12, minute: 15},
+		{layout: "B", value: "noon", hour: 12},
+		{layout: "b", value: "midnight", hour: 0},
+		{layout: "h:mm b", value: "3:00 PM", hour: 15},
+		{layout: "HH:mm B", value: "22:00 at night", hour: 22},
+		{layout: "Bh:mm", locale: "zh", value: "中午1:00", hour: 13},
+		{layout: "Bh:mm", locale: "zh", value: "中午12:30", hour: 12, minute: 30},
+		{layout: "Bh:mm", locale: "zh", value: "凌晨1:00", hour: 1},
+		{layout: "Bh:mm", locale: "ja", value: "夜中11:30", hour: 23, minute: 30},
+	}
+	for _, c := range testCases {
+		if !builtLocale(c.locale) {
+			continue
+		}
+		tm, err := datefmt.NewLayout(c.layout, datefmt.WithLocale(c.locale)).ParseWithDefaults(c.value, ref)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, c.value, err)
+		} else if want := time.Date(2022, 6, 20, c.hour, c.minute, 0, 0, time.UTC); !tm.Equal(want) {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, c.value, tm, want)
+		}
+	}
+	if _, err := datefmt.NewLayout("h B").Parse("10 at noon"); err == nil {
+		t.Errorf("Parse(%q) succeeded, want error", "10 at noon")
+	}
+}
+
+func TestWithDayPeriods(t *testing.T) {
+	l := datefmt.NewLayout("h:mm B", datefmt.WithDayPeriods(
+		datefmt.DayPeriod{Name: "before dawn", Start: 22 * time.Hour, End: 5 * time.Hour},
+		datefmt.DayPeriod{Name: "at lunch", Start: 12 * time.Hour, End: 14 * time.Hour},
+		datefmt.DayPeriod{Name: "at tea time", Start: 16 * time.Hour, End: 16 * time.Hour},
+	))
+	testCases := []struct {
+		hour, minute int
+		out          string
+	}{
+		{hour: 23, out: "11:00 before dawn"},
+		{hour: 2, minute: 30, out: "2:30 before dawn"},
+		{hour: 9, out: "9:00 AM"},
+		{hour: 13, out: "1:00 at lunch"},
+		{hour: 16, out: "4:00 at tea time"},
+		{hour: 16, minute: 1, out: "4:01 PM"},
+	}
+	for _, c := range testCases {
+		tm := time.Date(2022, 6, 20, c.hour, c.minute, 0, 0, time.UTC)
+		out := l.Format(tm)
+		if out != c.out {
+			t.Errorf("Format(%v) = %q, want %q", tm, out, c.out)
+			continue
+		}
+		if parsed, err := l.ParseWithDefaults(out, tm); err != nil || !parsed.Equal(tm) {
+			t.Errorf("Parse(%q) = %v, %v, want %v", out, parsed, err, tm)
+		}
+	}
+	if out := datefmt.NewLayout("h:mm b", datefmt.WithDayPeriods()).Format(time.Date(2022, 6, 20, 12, 0, 0, 0, time.UTC)); out != "12:00 noon" {
+		t.Errorf("Format = %q, want %q", out, "12:00 noon")
+	}
+}
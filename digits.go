@@ -0,0 +1,226 @@
+package datefmt
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Digits is a system of decimal digits, which replace the ASCII digits of the
+// numeric fields of a layout, see WithDigits.
+type Digits struct {
+	name       string
+	digits     [10]string
+	positional bool // numbers other than years, fractions and offsets are written with 十, 百 and 千
+}
+
+// The decimal digits of common numbering systems.
+var (
+	LatinDigits               = NewDigits("latn", '0')     // 0123456789
+	ArabicIndicDigits         = NewDigits("arab", '٠')     // ٠١٢٣٤٥٦٧٨٩
+	ExtendedArabicIndicDigits = NewDigits("arabext", '۰')  // ۰۱۲۳۴۵۶۷۸۹, used for Persian and Urdu
+	DevanagariDigits          = NewDigits("deva", '०')     // ०१२३४५६७८९
+	BengaliDigits             = NewDigits("beng", '০')     // ০১২৩৪৫৬৭৮৯
+	ThaiDigits                = NewDigits("thai", '๐')     // ๐๑๒๓๔๕๖๗๘๙
+	FullwidthDigits           = NewDigits("fullwide", '０') // ０１２３４５６７８９
+
+	// ChineseDigits writes numbers digit by digit, e.g. 二〇二二年六月二〇日.
+	ChineseDigits = &Digits{name: "hanidec", digits: chineseDigits}
+	// ChineseNumerals writes years digit by digit and the other fields positionally,
+	// like formal Chinese documents, e.g. 二〇二二年六月二十日. The padding of
+	// fixed-width fields is kept, e.g. 〇〇:〇五 with HH:mm.
+	ChineseNumerals = &Digits{name: "hans", digits: chineseDigits, positional: true}
+)
+
+var chineseDigits = [10]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// chineseUnits is the units of positional Chinese numerals, which are 10, 100 and 1000.
+var (
+	chineseUnits      = [...]string{"十", "百", "千"}
+	chineseUnitValues = [...]int{10, 100, 1000}
+)
+
+// chineseZero is the zero within positional Chinese numerals, e.g. 一百零五.
+const chineseZero = "零"
+
+// NewDigits returns the digits from zero to zero+9 named by the CLDR identifier
+// of the numbering system, e.g. NewDigits("mymr", '၀') for the Myanmar digits.
+func NewDigits(name string, zero rune) *Digits {
+	d := &Digits{name: name}
+	for i := range d.digits {
+		d.digits[i] = string(zero + rune(i))
+	}
+	return d
+}
+
+// String returns the CLDR identifier of the numbering system, e.g. "arab".
+func (d *Digits) String() string {
+	return d.name
+}
+
+// localized reports whether the digits of the arg are localized.
+func (arg *formatArg) localized() bool {
+	return arg.numeric() || arg.ph.flag == formatFlagZoneOffset
+}
+
+// positional reports whether the arg is written positionally with positional digits.
+func (arg *formatArg) positional() bool {
+	return arg.numeric() && !arg.year() && arg.ph.flag != formatFlagNanosecond
+}
+
+// localize replaces the ASCII digits in p[start:] with the digits, and the numbers
+// with positional numerals if positional is set.
+func (d *Digits) localize(p []byte, start int, positional bool) []byte {
+	var buf [32]byte
+	tail := append(buf[:0], p[start:]...)
+	p = p[:start]
+	for i := 0; i < len(tail); i++ {
+		c := tail[i]
+		if !isDigit(c) {
+			p = append(p, c)
+			continue
+		}
+		if positional && d.positional {
+			// the padding of fixed-width fields is kept, e.g. 〇五 for 05
+			for c == '0' && i+1 < len(tail) && isDigit(tail[i+1]) {
+				p = append(p, d.digits[0]...)
+				i++
+				c = tail[i]
+			}
+			v, n, _ := getNum(string(tail[i:]), 0, false)
+			if v < 10000 {
+				p = d.appendPositional(p, v)
+				i += n - 1
+				continue
+			}
+		}
+		p = append(p, d.digits[c-'0']...)
+	}
+	return p
+}
+
+// appendPositional appends v below 10000 in positional numerals, e.g. 二十 for 20.
+func (d *Digits) appendPositional(p []byte, v int) []byte {
+	if v == 0 {
+		return append(p, d.digits[0]...)
+	}
+	started, zero := false, false
+	for unit, pow := 3, 1000; pow > 0; unit, pow = unit-1, pow/10 {
+		digit := v / pow % 10
+		if digit == 0 {
+			zero = started
+			continue
+		}
+		if zero {
+			p = append(p, chineseZero...)
+			zero = false
+		}
+		if digit != 1 || unit != 1 || started {
+			// 十一 instead of 一十一
+			p = append(p, d.digits[digit]...)
+		}
+		if unit > 0 {
+			p = append(p, chineseUnits[unit-1]...)
+		}
+		started = true
+	}
+	return p
+}
+
+// delocalize replaces the numbers in value with ASCII digits.
+// The returned offsets are the offsets in value of each byte in the result, with
+// -1 within positional numbers, plus the offset of the end.
+func (d *Digits) delocalize(value string) (string, []int) {
+	var (
+		b       = make([]byte, 0, len(value))
+		offsets = make([]int, 0, len(value)+1)
+	)
+	i := 0
+	for i < len(value) {
+		if value[i] < utf8.RuneSelf {
+			b = append(b, value[i])
+			offsets = append(offsets, i)
+			i++
+			continue
+		}
+		if d.positional {
+			if v, n, ok := d.positionalAt(value[i:]); ok {
+				b = appendInt(b, v)
+				offsets = append(offsets, i)
+				for len(offsets) < len(b) {
+					offsets = append(offsets, -1)
+				}
+				i += n
+				continue
+			}
+		}
+		if n := d.digitAt(value[i:]); n >= 0 {
+			b = append(b, byte('0'+n))
+			offsets = append(offsets, i)
+			i += len(d.digits[n])
+			continue
+		}
+		// a letter, e.g. of a name, which is kept
+		_, size := utf8.DecodeRuneInString(value[i:])
+		for j := 0; j < size; j++ {
+			b = append(b, value[i+j])
+			offsets = append(offsets, i+j)
+		}
+		i += size
+	}
+	return string(b), append(offsets, i)
+}
+
+// positionalAt returns the positional number at the beginning of s and its length.
+// Numbers without units, e.g. years, are not positional, and the number ends
+// before a digit following another digit, e.g. 二 of 二二十.
+func (d *Digits) positionalAt(s string) (int, int, bool) {
+	var (
+		v, digit, n      int
+		hasUnit, pending bool
+	)
+	for n < len(s) {
+		if strings.HasPrefix(s[n:], chineseZero) {
+			n += len(chineseZero)
+			continue
+		}
+		if i := d.digitAt(s[n:]); i >= 0 {
+			if pending {
+				break
+			}
+			digit, pending = i, true
+			n += len(d.digits[i])
+			continue
+		}
+		unit := -1
+		for i, u := range chineseUnits {
+			if strings.HasPrefix(s[n:], u) {
+				unit = i
+			}
+		}
+		if unit < 0 {
+			break
+		}
+		if digit == 0 {
+			digit = 1 // 十 for 10
+		}
+		v += digit * chineseUnitValues[unit]
+		digit, pending = 0, false
+		hasUnit = true
+		n += len(chineseUnits[unit])
+	}
+	return v + digit, n, hasUnit
+}
+
+// digitAt returns the digit at the beginning of s, or -1 if there is none.
+func (d *Digits) digitAt(s string) int {
+	for i, digit := range d.digits {
+		if strings.HasPrefix(s, digit) {
+			return i
+		}
+	}
+	return -1
+}
+
+func appendInt(b []byte, v int) []byte {
+	return formatNum(b, v, 1)
+}
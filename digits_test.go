@@ -0,0 +1,134 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleWithDigits() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.NewLayout("yyyy-MM-dd HH:mm", datefmt.WithDigits(datefmt.ArabicIndicDigits)).Format(t))
+	fmt.Println(datefmt.NewLayout("y年M月d日", datefmt.WithDigits(datefmt.FullwidthDigits)).Format(t))
+	// Output:
+	// ٢٠٢٢-٠٦-٢٠ ٠٩:٤٩
+	// ２０２２年６月２０日
+}
+
+func TestDigits(t *testing.T) {
+	tm := time.Date(2022, time.June, 20, 9, 49, 10, 123000000, time.FixedZone("", 8*3600))
+	testCases := []struct {
+		layout string
+		digits *datefmt.Digits
+		locale datefmt.Locale
+		out    string
+	}{
+		{layout: "yyyy-MM-dd HH:mm:ss.SSS", digits: datefmt.LatinDigits, out: "2022-06-20 09:49:10.123"},
+		{layout: "yyyy-MM-dd HH:mm:ss.SSS", digits: datefmt.ArabicIndicDigits, out: "٢٠٢٢-٠٦-٢٠ ٠٩:٤٩:١٠.١٢٣"},
+		{layout: "d/M/yyyy h:mm a", digits: datefmt.ExtendedArabicIndicDigits, out: "۲۰/۶/۲۰۲۲ ۹:۴۹ AM"},
+		{layout: "dd MMM yyyy HH:mm", digits: datefmt.DevanagariDigits, out: "२० Jun २०२२ ०९:४९"},
+		{layout: "yyyyMMddHHmm", digits: datefmt.ThaiDigits, out: "๒๐๒๒๐๖๒๐๐๙๔๙"},
+		{layout: "y年M月d日 HH:mm", digits: datefmt.FullwidthDigits, locale: "zh", out: "２０２２年６月２０日 ０９:４９"},
+		{layout: "HH:mm XXX", digits: datefmt.BengaliDigits, out: "০৯:৪৯ +০৮:০০"},
+		{layout: "'Q1' HH:mm", digits: datefmt.FullwidthDigits, out: "Q1 ０９:４９"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithDigits(c.digits), datefmt.WithLocale(c.locale))
+		out := l.Format(tm)
+		if out != c.out {
+			t.Errorf("Format(%q, %s) = %q, want %q", c.layout, c.digits, out, c.out)
+			continue
+		}
+		parsed, err := l.ParseInLocation(out, tm.Location())
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, out, err)
+			continue
+		}
+		if want, err := datefmt.NewLayout(c.layout).ParseInLocation(datefmt.NewLayout(c.layout).Format(tm), tm.Location()); err != nil || !parsed.Equal(want) {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, out, parsed, want)
+		}
+	}
+}
+
+func TestDigitsParseASCII(t *testing.T) {
+	l := datefmt.NewLayout("yyyy-MM-dd", datefmt.WithDigits(datefmt.ArabicIndicDigits))
+	for _, value := range []string{"2022-06-20", "٢٠٢٢-٠٦-٢٠", "٢٠22-06-٢٠"} {
+		tm, err := l.Parse(value)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", value, err)
+		} else if want := time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC); !tm.Equal(want) {
+			t.Errorf("Parse(%q) = %v, want %v", value, tm, want)
+		}
+	}
+	if _, err := l.Parse("٢٠٢٢-٠٦-x"); err == nil {
+		t.Errorf("Parse(%q) succeeded, want error", "٢٠٢٢-٠٦-x")
+	}
+}
+
+func TestDigitsString(t *testing.T) {
+	if s := datefmt.NewDigits("mymr", '၀').String(); s != "mymr" {
+		t.Errorf("String() = %q, want %q", s, "mymr")
+	}
+	if s := datefmt.DevanagariDigits.String(); s != "deva" {
+		t.Errorf("String() = %q, want %q", s, "deva")
+	}
+}
+
+func TestChineseNumerals(t *testing.T) {
+	testCases := []struct {
+		layout string
+		digits *datefmt.Digits
+		time   time.Time
+		out    string
+	}{
+		{layout: "y年M月d日", digits: datefmt.ChineseDigits, time: time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC), out: "二〇二二年六月二〇日"},
+		{layout: "y年M月d日", digits: datefmt.ChineseNumerals, time: time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC), out: "二〇二二年六月二十日"},
+		{layout: "yyyy年MM月dd日", digits: datefmt.ChineseNumerals, time: time.Date(2000, 12, 1, 0, 0, 0, 0, time.UTC), out: "二〇〇〇年十二月〇一日"},
+		{layout: "y年M月d日H时m分", digits: datefmt.ChineseNumerals, time: time.Date(2022, 10, 15, 9, 5, 0, 0, time.UTC), out: "二〇二二年十月十五日九时五分"},
+		{layout: "H时mm分ss秒", digits: datefmt.ChineseNumerals, time: time.Date(2022, 1, 1, 23, 0, 59, 0, time.UTC), out: "二十三时〇〇分五十九秒"},
+		{layout: "HH:mm", digits: datefmt.ChineseNumerals, time: time.Date(2022, 1, 1, 0, 5, 0, 0, time.UTC), out: "〇〇:〇五"},
+		{layout: "HH:mm", digits: datefmt.ChineseNumerals, time: time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC), out: "十:三十"},
+		{layout: "y年第DDD天", digits: datefmt.ChineseNumerals, time: time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC), out: "二〇二二年第〇十五天"},
+		{layout: "H时mm分", digits: datefmt.ChineseDigits, time: time.Date(2022, 1, 1, 23, 0, 0, 0, time.UTC), out: "二三时〇〇分"},
+		{layout: "y年第D天", digits: datefmt.ChineseNumerals, time: time.Date(2022, 4, 15, 0, 0, 0, 0, time.UTC), out: "二〇二二年第一百零五天"},
+		{layout: "y年第D天", digits: datefmt.ChineseNumerals, time: time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC), out: "二〇二二年第一百一十天"},
+		{layout: "y年第D天", digits: datefmt.ChineseNumerals, time: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC), out: "二〇二二年第三百六十五天"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithDigits(c.digits), datefmt.WithLocale("zh"))
+		out := l.Format(c.time)
+		if out != c.out {
+			t.Errorf("Format(%q, %s) = %q, want %q", c.layout, c.digits, out, c.out)
+			continue
+		}
+		parsed, err := l.ParseWithDefaults(out, c.time)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, out, err)
+		} else if !parsed.Equal(c.time) {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, out, parsed, c.time)
+		}
+	}
+}
+
+func TestChineseNumeralsParse(t *testing.T) {
+	if !builtLocale("zh") {
+		t.Skip("the locale zh is not built")
+	}
+	l := datefmt.NewLayout("y年M月d日 EEEE", datefmt.WithDigits(datefmt.ChineseNumerals), datefmt.WithLocale("zh"))
+	want := time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"二〇二二年六月二十日 星期一", "2022年6月20日 星期一", "二〇二二年6月二〇日 星期一"} {
+		tm, err := l.Parse(value)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", value, err)
+		} else if !tm.Equal(want) {
+			t.Errorf("Parse(%q) = %v, want %v", value, tm, want)
+		}
+	}
+	for _, value := range []string{"二〇二二年十三月一日 星期一", "二〇二二年六月 星期一", "二〇二二年六月二二十日 星期一"} {
+		if _, err := l.Parse(value); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", value)
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package datefmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationLayout is a compiled layout for formatting and parsing time.Duration values.
+//
+// The placeholders of a duration layout are
+//
+//	d  days
+//	H  hours
+//	m  minutes
+//	s  seconds
+//	S  fraction of second, truncated to the number of letters
+//	-  sign, "-" for negative durations and nothing otherwise
+//	+  sign, "+" or "-"
+//
+// The largest unit of the layout holds the total of the duration, e.g. HH:mm:ss
+// formats 26 hours as "26:00:00" while d'd' H'h' formats it as "1d 2h".
+// Units smaller than the smallest unit of the layout are truncated.
+// A negative duration is prefixed with "-" when the layout has no sign placeholder.
+//
+// Text in square brackets is an optional section which is omitted when all of its
+// values are zero. If all values are zero, the sections holding the last value other
+// than the fraction are kept, so 'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']] formats
+// ISO 8601 durations like "PT1H30M" and "PT0S". When parsing, a section with values
+// is present only if at least one of them is, and at least one value of the layout
+// is required, so the layout rejects "P", "PT" and "P1DT".
+// A value below a larger parsed unit must be less than that unit, as Format writes
+// it, so HH:mm rejects "01:75" while the ISO 8601 layout accepts "PT90M".
+type DurationLayout struct {
+	max    int
+	units  durationUnit
+	sign   bool
+	items  []*durationItem
+	layout string
+}
+
+// NewDurationLayout compiles a duration layout.
+func NewDurationLayout(layout string) *DurationLayout {
+	l := DurationLayout{layout: layout}
+	l.items, _ = l.compile([]byte(layout), 0, false)
+	for i := len(l.items) - 1; i >= 0; i-- {
+		if l.items[i].markLast() {
+			break
+		}
+	}
+	return &l
+}
+
+func (l *DurationLayout) String() string {
+	return l.layout
+}
+
+type durationUnit int
+
+const (
+	durationFraction durationUnit = 1 << iota
+	durationSecond
+	durationMinute
+	durationHour
+	durationDay
+	durationSign
+)
+
+var durationUnitSizes = []struct {
+	unit durationUnit
+	size uint64
+	err  error // the error of a value out of range
+}{
+	{durationDay, uint64(24 * time.Hour), errDayRange},
+	{durationHour, uint64(time.Hour), errHourRange},
+	{durationMinute, uint64(time.Minute), errMinuteRange},
+	{durationSecond, uint64(time.Second), errSecondRange},
+}
+
+var durationPlaceholders = map[byte]durationUnit{
+	'd': durationDay,
+	'H': durationHour,
+	'm': durationMinute,
+	's': durationSecond,
+	'S': durationFraction,
+	'-': durationSign,
+	'+': durationSign,
+}
+
+// durationItem is a text, a placeholder or an optional section of a DurationLayout.
+type durationItem struct {
+	s       string
+	w       int
+	unit    durationUnit
+	section []*durationItem
+	last    bool // the section holds the last value other than the fraction
+}
+
+func (it *durationItem) numeric() bool {
+	return it.unit != 0 && it.unit != durationSign
+}
+
+// hasNumeric reports whether the items hold a numeric placeholder.
+func hasNumeric(items []*durationItem) bool {
+	for _, it := range items {
+		if it.numeric() || it.section != nil && hasNumeric(it.section) {
+			return true
+		}
+	}
+	return false
+}
+
+// markLast marks the sections holding the last value other than the fraction,
+// and reports whether the value is found in it.
+func (it *durationItem) markLast() bool {
+	if it.section == nil {
+		return it.unit >= durationSecond && it.unit <= durationDay
+	}
+	for i := len(it.section) - 1; i >= 0; i-- {
+		if it.section[i].markLast() {
+			it.last = true
+			return true
+		}
+	}
+	return false
+}
+
+// compile compiles the layout until the end of the section and returns the index after it.
+func (l *DurationLayout) compile(gl []byte, i int, inSection bool) ([]*durationItem, int) {
+	var (
+		items = []*durationItem{}
+		n     = len(gl)
+		sb    strings.Builder
+	)
+	flushBuffer := func() {
+		if sb.Len() > 0 {
+			items = append(items, &durationItem{s: sb.String(), w: sb.Len()})
+			l.max += sb.Len()
+			sb.Reset()
+		}
+	}
+	for ; i < n; i++ {
+		c := gl[i]
+		switch {
+		case c == '\'':
+			if i+1 < n && gl[i+1] == '\'' {
+				// real quote
+				sb.WriteByte('\'')
+				i++
+				continue
+			}
+			for i++; i < n; i++ {
+				if gl[i] == '\'' {
+					if i+1 < n && gl[i+1] == '\'' {
+						// real quote
+						sb.WriteByte('\'')
+						i++
+						continue
+					}
+					// end of text
+					break
+				}
+				sb.WriteByte(gl[i])
+			}
+		case c == '[':
+			flushBuffer()
+			section, end := l.compile(gl, i+1, true)
+			items = append(items, &durationItem{s: "[", section: section})
+			i = end
+		case c == ']' && inSection:
+			flushBuffer()
+			return items, i
+		case durationPlaceholders[c] != 0:
+			flushBuffer()
+			s := i
+			for i+1 < n && gl[i+1] == c {
+				i++
+			}
+			it := &durationItem{s: string(gl[s : i+1]), w: i + 1 - s, unit: durationPlaceholders[c]}
+			items = append(items, it)
+			l.units |= it.unit
+			switch it.unit {
+			case durationSign:
+				l.sign = true
+				l.max++
+			case durationFraction:
+				l.max += it.w
+			default:
+				// the largest unit holds the total, which has at most 19 digits
+				l.max += maxInt(it.w, 19)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	flushBuffer()
+	return items, n
+}
+
+// durationValues holds the absolute values of the units of a duration.
+type durationValues struct {
+	neg        bool
+	days       uint64
+	hours      uint64
+	minutes    uint64
+	seconds    uint64
+	nanosecond uint64
+	parsed     durationUnit // the units of the parsed values
+}
+
+func (v *durationValues) get(it *durationItem) uint64 {
+	switch it.unit {
+	case durationDay:
+		return v.days
+	case durationHour:
+		return v.hours
+	case durationMinute:
+		return v.minutes
+	case durationSecond:
+		return v.seconds
+	case durationFraction:
+		if it.w >= 9 {
+			return v.nanosecond
+		}
+		return v.nanosecond / pow10(9-it.w)
+	}
+	return 0
+}
+
+func (v *durationValues) set(unit durationUnit, n uint64) {
+	switch unit {
+	case durationDay:
+		v.days = n
+	case durationHour:
+		v.hours = n
+	case durationMinute:
+		v.minutes = n
+	case durationSecond:
+		v.seconds = n
+	case durationFraction:
+		v.nanosecond = n
+	}
+}
+
+// zero reports whether all values of the items are zero.
+func (v *durationValues) zero(items []*durationItem) bool {
+	for _, it := range items {
+		if it.section != nil && !v.zero(it.section) || it.numeric() && v.get(it) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Format returns the formatted duration.
+func (l *DurationLayout) Format(d time.Duration) string {
+	v := durationValues{neg: d < 0}
+	rest := uint64(d)
+	if v.neg {
+		rest = uint64(-d)
+	}
+	v.nanosecond = rest % uint64(time.Second)
+	for _, u := range durationUnitSizes {
+		if l.units&u.unit != 0 {
+			v.set(u.unit, rest/u.size)
+			rest %= u.size
+		}
+	}
+
+	p := make([]byte, 0, l.max+1)
+	if v.neg && !l.sign {
+		p = append(p, '-')
+	}
+	return readOnlyBytes2String(l.format(p, l.items, &v, v.zero(l.items)))
+}
+
+func (l *DurationLayout) format(p []byte, items []*durationItem, v *durationValues, zero bool) []byte {
+	for _, it := range items {
+		switch {
+		case it.section != nil:
+			if (zero && it.last) || !v.zero(it.section) {
+				p = l.format(p, it.section, v, zero)
+			}
+		case it.unit == 0:
+			p = append(p, it.s...)
+		case it.unit == durationSign:
+			if v.neg {
+				p = append(p, '-')
+			} else if it.s[0] == '+' {
+				p = append(p, '+')
+			}
+		case it.unit == durationFraction:
+			p = formatNanosecond(p, int(v.nanosecond), it.w)
+		default:
+			p = formatNum(p, int(v.get(it)), it.w)
+		}
+	}
+	return p
+}
+
+var (
+	errDurationRange   = errors.New("duration out of range")
+	errNoDurationValue = errors.New("no value")
+)
+
+// Parse parses a formatted string and returns the duration value it represents.
+// Units omitted from the layout are assumed to be zero.
+func (l *DurationLayout) Parse(value string) (time.Duration, error) {
+	var (
+		v    durationValues
+		rest = value
+		err  error
+	)
+	if !l.sign && len(rest) > 0 && rest[0] == '-' {
+		v.neg = true
+		rest = rest[1:]
+	}
+	if rest, err = l.parse(l.items, &v, value, rest); err != nil {
+		return 0, err
+	}
+	if len(rest) > 0 {
+		return 0, &ParseError{Layout: l.layout, Value: value, Message: ": extra text: " + strconv.Quote(rest)}
+	}
+	if v.parsed == 0 && hasNumeric(l.items) {
+		// all values are in omitted sections
+		return 0, &ParseError{Layout: l.layout, Value: value, Message: ": " + errNoDurationValue.Error()}
+	}
+
+	total := v.nanosecond
+	larger := uint64(0) // the size of the next larger parsed unit
+	for _, u := range durationUnitSizes {
+		n := v.get(&durationItem{unit: u.unit})
+		if larger > 0 && n >= larger/u.size {
+			// only the largest parsed unit holds the total, e.g. 75 minutes is 1:15
+			return 0, &ParseError{Layout: l.layout, Value: value, Message: ": " + u.err.Error()}
+		}
+		if v.parsed&u.unit != 0 {
+			larger = u.size
+		}
+		if n > (1<<63)/u.size || total+n*u.size < total {
+			return 0, &ParseError{Layout: l.layout, Value: value, Message: ": " + errDurationRange.Error()}
+		}
+		total += n * u.size
+	}
+	if total > 1<<63 || (total == 1<<63 && !v.neg) {
+		return 0, &ParseError{Layout: l.layout, Value: value, Message: ": " + errDurationRange.Error()}
+	}
+	if v.neg {
+		return -time.Duration(total), nil
+	}
+	return time.Duration(total), nil
+}
+
+func (l *DurationLayout) parse(items []*durationItem, v *durationValues, value, rest string) (string, error) {
+	for i, it := range items {
+		switch {
+		case it.section != nil:
+			saved := *v
+			// a section with values is present only when one of them is parsed,
+			// e.g. "T" is not a valid time part of an ISO 8601 duration
+			if r, err := l.parse(it.section, v, value, rest); err == nil && (v.parsed != saved.parsed || !hasNumeric(it.section)) {
+				rest = r
+			} else {
+				// the section is omitted
+				*v = saved
+			}
+		case it.unit == 0:
+			if !strings.HasPrefix(rest, it.s) {
+				return rest, &ParseError{Layout: l.layout, Value: value, LayoutElem: it.s, ValueElem: rest}
+			}
+			rest = rest[len(it.s):]
+		case it.unit == durationSign:
+			if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+' && it.s[0] == '+') {
+				v.neg = rest[0] == '-'
+				rest = rest[1:]
+			} else if it.s[0] == '+' {
+				return rest, &ParseError{Layout: l.layout, Value: value, LayoutElem: it.s, ValueElem: rest}
+			}
+		default:
+			// abutting numeric fields consume exactly as many digits as the placeholder width
+			fixed := i+1 < len(items) && items[i+1].numeric()
+			n, length, ok := getNum(rest, it.w, fixed)
+			if !ok {
+				return rest, &ParseError{Layout: l.layout, Value: value, LayoutElem: it.s, ValueElem: rest}
+			}
+			if it.unit == durationFraction {
+				n = 0
+				for j := 0; j < 9; j++ {
+					n *= 10
+					if j < length {
+						n += int(rest[j] - '0')
+					}
+				}
+			}
+			v.set(it.unit, uint64(n))
+			v.parsed |= it.unit
+			rest = rest[length:]
+		}
+	}
+	return rest, nil
+}
+
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
@@ -0,0 +1,126 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleDurationLayout() {
+	d := 26*time.Hour + 30*time.Minute + 1500*time.Millisecond
+	fmt.Println(datefmt.NewDurationLayout("HH:mm:ss.SSS").Format(d))
+	fmt.Println(datefmt.NewDurationLayout("d'd' H'h'").Format(d))
+	fmt.Println(datefmt.NewDurationLayout("'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]").Format(90 * time.Minute))
+
+	d, _ = datefmt.NewDurationLayout("HH:mm:ss").Parse("100:00:30")
+	fmt.Println(d)
+	// Output:
+	// 26:30:01.500
+	// 1d 2h
+	// PT1H30M
+	// 100h0m30s
+}
+
+func TestDurationLayout(t *testing.T) {
+	const iso = "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]"
+	testCases := []struct {
+		layout string
+		d      time.Duration
+		out    string
+	}{
+		{layout: "HH:mm:ss", d: 0, out: "00:00:00"},
+		{layout: "HH:mm:ss", d: 3*time.Hour + 4*time.Minute + 5*time.Second, out: "03:04:05"},
+		{layout: "HH:mm:ss", d: 100*time.Hour + 59*time.Second + 999*time.Millisecond, out: "100:00:59"},
+		{layout: "HH:mm:ss", d: -90 * time.Second, out: "-00:01:30"},
+		{layout: "H:mm:ss.SSS", d: 1234 * time.Millisecond, out: "0:00:01.234"},
+		{layout: "mm:ss.S", d: 61*time.Minute + 250*time.Millisecond, out: "61:00.2"},
+		{layout: "s.SSSSSSSSS's'", d: time.Nanosecond, out: "0.000000001s"},
+		{layout: "d'd' H'h' m'm'", d: 49*time.Hour + 5*time.Minute, out: "2d 1h 5m"},
+		{layout: "d'd' HH'h'", d: 23 * time.Hour, out: "0d 23h"},
+		{layout: "-d'd'", d: 48 * time.Hour, out: "2d"},
+		{layout: "-d'd'", d: -48 * time.Hour, out: "-2d"},
+		{layout: "+HH:mm", d: 90 * time.Minute, out: "+01:30"},
+		{layout: "+HH:mm", d: -90 * time.Minute, out: "-01:30"},
+		{layout: "HH''mm", d: 90 * time.Minute, out: "01'30"},
+		{layout: "HHmmss", d: 90 * time.Minute, out: "013000"},
+		{layout: "ss", d: math.MaxInt64, out: "9223372036"},
+		{layout: "ss", d: math.MinInt64, out: "-9223372036"},
+		{layout: iso, d: 0, out: "PT0S"},
+		{layout: iso, d: 90 * time.Minute, out: "PT1H30M"},
+		{layout: iso, d: 30 * time.Second, out: "PT30S"},
+		{layout: iso, d: 1500 * time.Millisecond, out: "PT1.500S"},
+		{layout: iso, d: 49 * time.Hour, out: "P2DT1H"},
+		{layout: iso, d: 48 * time.Hour, out: "P2D"},
+		{layout: iso, d: -48*time.Hour - time.Second, out: "-P2DT1S"},
+		{layout: "'P'[d'D']", d: time.Hour, out: "P0D"},
+	}
+	for _, tc := range testCases {
+		l := datefmt.NewDurationLayout(tc.layout)
+		if out := l.Format(tc.d); out != tc.out {
+			t.Errorf("Format(%v) with %q = %q, want %q", tc.d, tc.layout, out, tc.out)
+			continue
+		}
+		d, err := l.Parse(tc.out)
+		if err != nil {
+			t.Errorf("Parse(%q) with %q: %v", tc.out, tc.layout, err)
+			continue
+		}
+		if out := l.Format(d); out != tc.out {
+			t.Errorf("Parse(%q) with %q = %v, formatted as %q", tc.out, tc.layout, d, out)
+		}
+	}
+}
+
+func TestDurationLayoutParse(t *testing.T) {
+	testCases := []struct {
+		layout string
+		value  string
+		d      time.Duration
+		err    bool
+	}{
+		{layout: "HH:mm:ss", value: "1:2:3", d: time.Hour + 2*time.Minute + 3*time.Second},
+		{layout: "HH:mm:ss", value: "-1:00:00", d: -time.Hour},
+		{layout: "HH:mm:ss.SSS", value: "00:00:01.5", d: 1500 * time.Millisecond},
+		{layout: "'PT'[H'H'][m'M'][s'S']", value: "PT90M", d: 90 * time.Minute},
+		{layout: "'PT'[H'H'][m'M'][s'S']", value: "PT", err: true},
+		{layout: "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]", value: "P1DT2H", d: 26 * time.Hour},
+		{layout: "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]", value: "PT0S", d: 0},
+		{layout: "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]", value: "P", err: true},
+		{layout: "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]", value: "PT", err: true},
+		{layout: "'P'[d'D']['T'[H'H'][m'M'][s[.SSS]'S']]", value: "P1DT", err: true},
+		{layout: "[H'h']", value: "", err: true},
+		{layout: "[H'h'][' 'm'm']", value: "1h 30m", d: 90 * time.Minute},
+		{layout: "ss", value: "9223372036", d: 9223372036 * time.Second},
+		{layout: "ss", value: "9223372037", err: true},
+		{layout: "d", value: "106752", err: true},
+		{layout: "+HH", value: "01", err: true},
+		{layout: "HH:mm", value: "01:30x", err: true},
+		{layout: "HH:mm", value: "01-30", err: true},
+		{layout: "HH:mm", value: "01:75", err: true},
+		{layout: "HH:mm", value: "01:60", err: true},
+		{layout: "HH:mm:ss", value: "01:00:60", err: true},
+		{layout: "d'd' H'h'", value: "1d 24h", err: true},
+		{layout: "d'd' H'h'", value: "1d 23h", d: 47 * time.Hour},
+		{layout: "d'd' mm", value: "1d 90", d: 24*time.Hour + 90*time.Minute},
+		{layout: "d'd' mm", value: "1d 1440", err: true},
+		{layout: "mm:ss", value: "90:59", d: 90*time.Minute + 59*time.Second},
+		{layout: "'PT'[H'H'][m'M'][s'S']", value: "PT1H75M", err: true},
+	}
+	for _, tc := range testCases {
+		d, err := datefmt.NewDurationLayout(tc.layout).Parse(tc.value)
+		if tc.err {
+			if err == nil {
+				t.Errorf("Parse(%q) with %q = %v, want error", tc.value, tc.layout, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q) with %q: %v", tc.value, tc.layout, err)
+		} else if d != tc.d {
+			t.Errorf("Parse(%q) with %q = %v, want %v", tc.value, tc.layout, d, tc.d)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package datefmt
+
+import (
+	"strconv"
+	"time"
+)
+
+// ParseFields parses a formatted string and returns the fields present in it
+// without resolving them into a time value.
+func (l *Layout) ParseFields(value string) (Fields, error) {
+	return l.parse(value)
+}
+
+// Field is a set of flags identifying the fields of Fields.
+type Field uint
+
+const (
+	FieldEra Field = 1 << iota
+	FieldYear
+	FieldWeekYear
+	FieldMonth
+	FieldWeek
+	FieldWeekInMonth
+	FieldYearDay
+	FieldDay
+	FieldDayOfWeekInMonth
+	FieldWeekday
+	FieldPM
+	FieldHour
+	FieldMinute
+	FieldSecond
+	FieldNanosecond
+	FieldZoneName
+	FieldZoneOffset
+	FieldZoneID
+)
+
+// Fields holds the values of the fields of a formatted string.
+// A value is meaningful only if its field is present, see Has.
+//
+// If the fields are parsed with a calendar, see WithCalendar, the Era, Year,
+// Month, Day and YearDay are in that calendar.
+type Fields struct {
+	Era              int // 0 for BC, 1 for AD
+	Year             int // year of era if Era is present, otherwise proleptic year
+	WeekYear         int
+	Month            int
+	LeapMonth        bool // the month is a leap month, see Chinese
+	Week             int  // ISO 8601 week in week year
+	WeekInMonth      int
+	YearDay          int
+	Day              int
+	DayOfWeekInMonth int
+	Weekday          time.Weekday
+	PM               bool
+	Hour             int // 0-23, or 0-11 if the value has no am/pm marker but a 12-hour clock
+	Minute           int
+	Second           int
+	Nanosecond       int
+	ZoneName         string
+	ZoneOffset       int    // seconds east of UTC
+	ZoneID           string // e.g. America/Los_Angeles, resolved by time.LoadLocation
+
+	present  Field
+	cal      Calendar
+	zoneKind int // zoneStandard or zoneDaylight if the zone is parsed from a specific name
+}
+
+// Has reports whether all the given fields are present.
+func (f *Fields) Has(field Field) bool {
+	return f.present&field == field
+}
+
+// Set marks the given fields as present.
+func (f *Fields) Set(field Field) {
+	f.present |= field
+}
+
+func (f *Fields) hasAny(field Field) bool {
+	return f.present&field != 0
+}
+
+// fieldSignificance lists the fields from the most significant to the least significant one,
+// along with the fields that make them present.
+var fieldSignificance = []struct{ field, present Field }{
+	{FieldYear, FieldYear | FieldWeekYear},
+	{FieldMonth, FieldMonth | FieldYearDay | FieldWeek},
+	{FieldDay, FieldDay | FieldYearDay | FieldWeek | FieldDayOfWeekInMonth | FieldWeekInMonth},
+	{FieldHour, FieldHour},
+	{FieldMinute, FieldMinute},
+	{FieldSecond, FieldSecond},
+	{FieldNanosecond, FieldNanosecond},
+}
+
+// fill sets the fields more significant than the most significant present one from ref.
+func (f *Fields) fill(ref time.Time) {
+	if f.cal == nil && !f.hasAny(FieldYear|FieldWeekYear) {
+		switch {
+		case f.Has(FieldWeek):
+			// the week in year is counted in the ISO week-year, which differs
+			// from the year of ref around the new year
+			f.WeekYear, _ = ref.ISOWeek()
+			f.present |= FieldWeekYear
+		case f.Has(FieldWeekday) && !f.hasAny(FieldMonth|FieldDay|FieldYearDay|FieldDayOfWeekInMonth|FieldWeekInMonth):
+			// a day of week alone is the day in the ISO week of ref
+			f.WeekYear, f.Week = ref.ISOWeek()
+			f.present |= FieldWeekYear | FieldWeek
+		}
+	}
+	top := len(fieldSignificance)
+	for i, sig := range fieldSignificance {
+		if f.hasAny(sig.present) {
+			top = i
+			break
+		}
+	}
+	if top == 0 {
+		return
+	}
+	year, month, day := ref.Date()
+	hour, minute, second := ref.Clock()
+	values := []int{year, int(month), day, hour, minute, second, ref.Nanosecond()}
+	calendarYear := f.cal != nil && !f.hasAny(FieldEra|FieldYear)
+	if f.cal != nil {
+		d := f.cal.Date(ref)
+		values[0], values[1], values[2] = d.Year, d.Month, d.Day
+		if top > 1 {
+			f.LeapMonth = d.LeapMonth
+		}
+		if !f.Has(FieldEra) {
+			f.Era = d.Era
+			f.present |= FieldEra
+		}
+	}
+	for i := 0; i < top; i++ {
+		f.setNum(fieldSignificance[i].field, values[i])
+	}
+	if gc, ok := f.cal.(gregorianMonthCalendar); ok && calendarYear && f.Has(FieldMonth) && gc.gregorianMonths() {
+		// an era may begin within the year of ref, so take the era and year of the
+		// date in the Gregorian year of ref, e.g. 12-31 in 2019 is in Reiwa 1, not Heisei 31
+		day := 1
+		if f.Has(FieldDay) {
+			day = f.Day
+		}
+		d := f.cal.Date(time.Date(year, time.Month(f.Month), day, 0, 0, 0, 0, time.UTC))
+		f.Era, f.Year = d.Era, d.Year
+	}
+}
+
+func (f *Fields) setNum(field Field, v int) {
+	switch field {
+	case FieldYear:
+		f.Year = v
+	case FieldWeekYear:
+		f.WeekYear = v
+	case FieldMonth:
+		f.Month = v
+	case FieldWeek:
+		f.Week = v
+	case FieldWeekInMonth:
+		f.WeekInMonth = v
+	case FieldYearDay:
+		f.YearDay = v
+	case FieldDay:
+		f.Day = v
+	case FieldDayOfWeekInMonth:
+		f.DayOfWeekInMonth = v
+	case FieldHour:
+		f.Hour = v
+	case FieldMinute:
+		f.Minute = v
+	case FieldSecond:
+		f.Second = v
+	case FieldNanosecond:
+		f.Nanosecond = v
+	}
+	f.present |= field
+}
+
+// Time resolves the fields into a time value.
+// Missing fields are assumed to be zero or, when zero is impossible, one.
+// The date is resolved from the first available of: month and day, day of year,
+// and week year, week and weekday.
+// The time is in the given location if the fields contain no time zone information.
+func (f *Fields) Time(loc *time.Location) (time.Time, error) {
+	year, month, day, err := f.date()
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch {
+	case f.Hour < 0 || f.Hour > 23:
+		return time.Time{}, errHourRange
+	case f.Minute < 0 || f.Minute > 59:
+		return time.Time{}, errMinuteRange
+	case f.Second < 0 || f.Second > 59:
+		return time.Time{}, errSecondRange
+	}
+
+	date := func(loc *time.Location) time.Time {
+		return time.Date(year, time.Month(month), day, f.Hour, f.Minute, f.Second, f.Nanosecond, loc)
+	}
+	if f.Has(FieldZoneOffset) {
+		if f.ZoneOffset == 0 && f.ZoneName == "UTC" {
+			return date(time.UTC), nil
+		}
+		t := date(time.UTC).Add(-time.Duration(f.ZoneOffset) * time.Second)
+		// Use the location if its offset at the given time is the same.
+		if name, offset := t.In(loc).Zone(); offset == f.ZoneOffset && (f.ZoneName == "" || name == f.ZoneName) {
+			return t.In(loc), nil
+		}
+		return t.In(time.FixedZone(f.ZoneName, f.ZoneOffset)), nil
+	}
+	if f.Has(FieldZoneID) {
+		return zoneTime(date, f.ZoneID, f.zoneKind)
+	}
+	if f.Has(FieldZoneName) {
+		if f.ZoneName == "UTC" {
+			return date(time.UTC), nil
+		}
+		if t := date(loc); zoneName(t) == f.ZoneName {
+			return t, nil
+		}
+		if id, kind, ok := zoneNamesEN.lookupSpecific(f.ZoneName); ok && f.ZoneName != "GMT" {
+			// a short specific name of CLDR, e.g. PDT for America/Los_Angeles
+			return zoneTime(date, id, kind)
+		}
+		// Otherwise create a fake zone with unknown offset.
+		offset := 0
+		if len(f.ZoneName) > 3 && f.ZoneName[:3] == "GMT" {
+			offset, _ = strconv.Atoi(f.ZoneName[3:])
+			offset *= 3600
+		}
+		t := date(time.UTC).Add(-time.Duration(offset) * time.Second)
+		return t.In(time.FixedZone(f.ZoneName, offset)), nil
+	}
+	return date(loc), nil
+}
+
+// zoneTime returns the date in the zone, or in the offset of the kind of the
+// zone if the kind is specific and the zone is not in it at the date.
+func zoneTime(date func(*time.Location) time.Time, id string, kind int) (time.Time, error) {
+	zone, err := time.LoadLocation(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t := date(zone)
+	if dst, _ := zoneDST(t); kind != zoneGeneric && dst != (kind == zoneDaylight) {
+		// the offset of the name, e.g. Pacific Standard Time in summer or
+		// Mountain Standard Time in Phoenix, which is parsed as Denver
+		offset := zoneKindOffset(t, kind)
+		return date(time.UTC).Add(-time.Duration(offset) * time.Second).In(time.FixedZone("", offset)), nil
+	}
+	return t, nil
+}
+
+func (f *Fields) date() (year, month, day int, err error) {
+	if f.cal != nil && f.hasAny(FieldEra|FieldYear|FieldMonth|FieldDay|FieldDayOfWeekInMonth|FieldYearDay) {
+		if !f.Has(FieldYear) {
+			// unlike the Gregorian year 0, the first year of a calendar is
+			// never a useful default
+			return 0, 0, 0, errNoYear
+		}
+		t, err := f.cal.Time(f.calendarDate(), time.UTC)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		return t.Year(), int(t.Month()), t.Day(), nil
+	}
+	year, month, day = f.Year, 1, 1
+	if f.Has(FieldEra) && f.Has(FieldYear) {
+		// years of era start from 1, and the year 1 BC is the year 0
+		if year < 1 {
+			return 0, 0, 0, errYearRange
+		}
+		if f.Era == 0 {
+			year = 1 - year
+		}
+	}
+	if !f.Has(FieldYear) && f.Has(FieldWeekYear) {
+		year = f.WeekYear
+	}
+	switch {
+	case f.hasAny(FieldMonth | FieldDay | FieldDayOfWeekInMonth | FieldWeekInMonth):
+		if f.Has(FieldMonth) {
+			month = f.Month
+		}
+		if month < 1 || month > 12 {
+			return 0, 0, 0, errMonthRange
+		}
+		first := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).Weekday()
+		switch {
+		case f.Has(FieldDay):
+			day = f.Day
+		case f.Has(FieldDayOfWeekInMonth):
+			day = (f.DayOfWeekInMonth-1)*7 + 1
+			if f.Has(FieldWeekday) {
+				day += int(f.Weekday-first+7) % 7
+			}
+		case f.Has(FieldWeekInMonth):
+			// the first week of month is the one containing the first day of month
+			day = (f.WeekInMonth-1)*7 + 1 - daysSinceMonday(first)
+			if f.Has(FieldWeekday) {
+				day += daysSinceMonday(f.Weekday)
+			}
+		}
+		if day < 1 || day > daysIn(time.Month(month), year) {
+			return 0, 0, 0, errDayRange
+		}
+	case f.Has(FieldYearDay):
+		if f.YearDay < 1 || f.YearDay > daysInYear(year) {
+			return 0, 0, 0, errDayRange
+		}
+		t := time.Date(year, time.January, f.YearDay, 0, 0, 0, 0, time.UTC)
+		month, day = int(t.Month()), t.Day()
+	case f.Has(FieldWeek):
+		if f.Has(FieldWeekYear) {
+			year = f.WeekYear
+		}
+		// the first week of year is the one containing January 4th
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		days := (f.Week-1)*7 - daysSinceMonday(jan4.Weekday())
+		if f.Has(FieldWeekday) {
+			days += daysSinceMonday(f.Weekday)
+		}
+		t := jan4.AddDate(0, 0, days)
+		if wy, w := t.ISOWeek(); wy != year || w != f.Week {
+			return 0, 0, 0, errDayRange
+		}
+		year, month, day = t.Year(), int(t.Month()), t.Day()
+	}
+	return year, month, day, nil
+}
+
+func daysSinceMonday(weekday time.Weekday) int {
+	return int(weekday+6) % 7
+}
+
+func zoneName(t time.Time) string {
+	name, _ := t.Zone()
+	return name
+}
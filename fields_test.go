@@ -0,0 +1,121 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleLayout_ParseFields() {
+	l := datefmt.NewLayout("MM-dd HH:mm")
+	f, _ := l.ParseFields("06-20 09:49")
+	fmt.Println(f.Has(datefmt.FieldMonth|datefmt.FieldDay), f.Month, f.Day)
+	fmt.Println(f.Has(datefmt.FieldYear))
+	// Output:
+	// true 6 20
+	// false
+}
+
+func TestLayoutParseFields(t *testing.T) {
+	testCases := []struct {
+		layout  string
+		in      string
+		present datefmt.Field
+		absent  datefmt.Field
+		want    datefmt.Fields
+	}{
+		{
+			layout:  "G yyyy-MM-dd",
+			in:      "AD 2022-06-20",
+			present: datefmt.FieldEra | datefmt.FieldYear | datefmt.FieldMonth | datefmt.FieldDay,
+			absent:  datefmt.FieldHour | datefmt.FieldZoneName | datefmt.FieldZoneOffset,
+			want:    datefmt.Fields{Era: 1, Year: 2022, Month: 6, Day: 20},
+		},
+		{
+			layout:  "h:mm:ss.SSS a",
+			in:      "9:49:10.181 PM",
+			present: datefmt.FieldHour | datefmt.FieldMinute | datefmt.FieldSecond | datefmt.FieldNanosecond | datefmt.FieldPM,
+			absent:  datefmt.FieldYear | datefmt.FieldMonth | datefmt.FieldDay,
+			want:    datefmt.Fields{PM: true, Hour: 21, Minute: 49, Second: 10, Nanosecond: 181000000},
+		},
+		{
+			layout:  "YYYY-'W'ww-u",
+			in:      "2022-W25-1",
+			present: datefmt.FieldWeekYear | datefmt.FieldWeek | datefmt.FieldWeekday,
+			absent:  datefmt.FieldYear,
+			want:    datefmt.Fields{WeekYear: 2022, Week: 25, Weekday: time.Monday},
+		},
+		{
+			layout:  "yyyy DDD EEEE",
+			in:      "2022 171 Monday",
+			present: datefmt.FieldYear | datefmt.FieldYearDay | datefmt.FieldWeekday,
+			absent:  datefmt.FieldMonth | datefmt.FieldDay,
+			want:    datefmt.Fields{Year: 2022, YearDay: 171, Weekday: time.Monday},
+		},
+		{
+			layout:  "z XXX",
+			in:      "CST +08:00",
+			present: datefmt.FieldZoneName | datefmt.FieldZoneOffset,
+			want:    datefmt.Fields{ZoneName: "CST", ZoneOffset: 8 * 3600},
+		},
+	}
+	for _, c := range testCases {
+		f, err := datefmt.NewLayout(c.layout).ParseFields(c.in)
+		if err != nil {
+			t.Errorf("ParseFields(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if !f.Has(c.present) {
+			t.Errorf("ParseFields(%s, %s) has no fields %b", c.layout, c.in, c.present)
+		}
+		for field := datefmt.Field(1); field <= c.absent; field <<= 1 {
+			if c.absent&field != 0 && f.Has(field) {
+				t.Errorf("ParseFields(%s, %s) has unexpected field %b", c.layout, c.in, field)
+			}
+		}
+		c.want.Set(c.present)
+		if f != c.want {
+			t.Errorf("ParseFields(%s, %s) = %+v; want %+v", c.layout, c.in, f, c.want)
+		}
+	}
+}
+
+func TestFieldsTime(t *testing.T) {
+	testCases := []struct {
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{layout: "YYYY-'W'ww-u", in: "2022-W25-1", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "YYYY-'W'ww-u", in: "2021-W52-6", out: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "YYYY-'W'ww-u", in: "2025-W01-1", out: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{layout: "YYYY-'W'ww", in: "2022-W25", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy-MM W E", in: "2022-07 2 Fri", out: time.Date(2022, time.July, 8, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy-MM W E", in: "2022-07 1 Sun", out: time.Date(2022, time.July, 3, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy-MM F E", in: "2022-06 3 Mon", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy-MM F", in: "2022-06 3", out: time.Date(2022, time.June, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		f, err := datefmt.NewLayout(c.layout).ParseFields(c.in)
+		if err != nil {
+			t.Errorf("ParseFields(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		r, err := f.Time(time.UTC)
+		if err != nil {
+			t.Errorf("Fields(%s, %s).Time() returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("Fields(%s, %s).Time() = %s; want %s", c.layout, c.in, r, c.out)
+		}
+	}
+
+	f := datefmt.Fields{Year: 2022, Week: 53}
+	f.Set(datefmt.FieldYear | datefmt.FieldWeek)
+	if _, err := f.Time(time.UTC); err == nil {
+		t.Errorf("Fields.Time() should return error for week 53 of 2022")
+	}
+}
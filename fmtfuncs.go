@@ -83,13 +83,8 @@ func formatNum(p []byte, v, w int) []byte {
 		u = uint(-v)
 	}
 
-	var buf [10]byte
-	if !(w <= 1 || (w == 2 && u > 9) || (w == 4 && u > 999)) {
-		buf = [10]byte{'0', '0', '0', '0', '0', '0', '0', '0', '0', '0'}
-	}
-
-	n := len(buf)
-	i := n
+	var buf [20]byte
+	i := len(buf)
 	for u >= 10 {
 		i--
 		buf[i] = byte('0' + u%10)
@@ -98,11 +93,8 @@ func formatNum(p []byte, v, w int) []byte {
 	i--
 	buf[i] = byte('0' + u)
 
-	if w > 0 && w > n-i {
-		if w > 9 {
-			w = 9
-		}
-		return append(p, buf[n-w:]...)
+	for n := len(buf) - i; n < w; n++ {
+		p = append(p, '0')
 	}
 	return append(p, buf[i:]...)
 }
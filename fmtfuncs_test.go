@@ -60,7 +60,12 @@ func Test_formatFuncs(t *testing.T) {
 
 	t.Run("formatNum", func(t *testing.T) {
 		check(t, formatNum, -1, 2, "-01")
-		check(t, formatNum, 9, 30, "000000009")
+		check(t, formatNum, 9, 12, "000000000009")
+		check(t, formatNum, 12345, 4, "12345")
+		check(t, formatNum, -12345, 6, "-012345")
+		check(t, formatNum, 292277026596, 4, "292277026596")
+		check(t, formatNum, -292277026596, 0, "-292277026596")
+		check(t, formatNum, 9223372036854775807, 0, "9223372036854775807")
 	})
 
 	check2 := func(t *testing.T, f func(p []byte, v uint) []byte, v uint, r string) {
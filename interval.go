@@ -0,0 +1,189 @@
+package datefmt
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// IntervalForm is the representation of an ISO 8601 time interval.
+type IntervalForm int
+
+const (
+	// IntervalStartEnd is the start and the end, e.g. 2022-06-20T09:00Z/2022-06-20T10:00Z.
+	IntervalStartEnd IntervalForm = iota
+	// IntervalStartPeriod is the start and a duration, e.g. 2022-06-20T09:00Z/PT1H.
+	IntervalStartPeriod
+	// IntervalPeriodEnd is a duration and the end, e.g. PT1H/2022-06-20T10:00Z.
+	IntervalPeriodEnd
+	// IntervalAbbreviatedEnd is the start and the end without the leading fields
+	// it shares with the start, e.g. 2022-06-20/25.
+	IntervalAbbreviatedEnd
+)
+
+// Interval is an ISO 8601 time interval.
+type Interval struct {
+	Start  time.Time
+	End    time.Time
+	Period Period // the duration of IntervalStartPeriod and IntervalPeriodEnd
+	Form   IntervalForm
+}
+
+// NewInterval returns the interval from start to end.
+func NewInterval(start, end time.Time) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// NewStartPeriodInterval returns the interval of the period after start.
+func NewStartPeriodInterval(start time.Time, p Period) Interval {
+	return Interval{Start: start, End: p.AddTo(start), Period: p, Form: IntervalStartPeriod}
+}
+
+// NewPeriodEndInterval returns the interval of the period before end.
+func NewPeriodEndInterval(p Period, end time.Time) Interval {
+	return Interval{Start: p.Negate().AddTo(end), End: end, Period: p, Form: IntervalPeriodEnd}
+}
+
+// intervalSeparator returns the separator of the start and the end, which is
+// "--" if the layout contains a solidus.
+func intervalSeparator(l *Layout) string {
+	if strings.ContainsRune(l.layout, '/') {
+		return "--"
+	}
+	return "/"
+}
+
+// FormatInterval returns the interval in its form, the times are formatted with the layout.
+//
+// The abbreviated end starts at the first field of the layout which differs from
+// the start, e.g. 2022-06-20/25 or 2022-06-20T09:00/10:30 with yyyy-MM-dd'T'HH:mm.
+// Note that the times are formatted in their own locations.
+func FormatInterval(iv Interval, l *Layout) string {
+	sep := intervalSeparator(l)
+	switch iv.Form {
+	case IntervalStartPeriod:
+		return l.Format(iv.Start) + sep + iv.Period.String()
+	case IntervalPeriodEnd:
+		return iv.Period.String() + sep + l.Format(iv.End)
+	case IntervalAbbreviatedEnd:
+		return l.Format(iv.Start) + sep + l.subLayout(l.abbreviate(iv.Start, iv.End), len(l.args)).Format(iv.End)
+	}
+	return l.Format(iv.Start) + sep + l.Format(iv.End)
+}
+
+// abbreviate returns the index of the first placeholder formatted differently for t and u,
+// or 0 if there is none.
+func (l *Layout) abbreviate(t, u time.Time) int {
+	for i, arg := range l.args {
+		if arg.ph.flag == formatFlagNone {
+			continue
+		}
+		if sub := l.subLayout(i, i+1); sub.Format(t) != sub.Format(u) {
+			return i
+		}
+	}
+	return 0
+}
+
+var errIntervalOrder = errors.New("interval end before start")
+
+// ParseInterval parses an ISO 8601 time interval, the times are parsed with the layout.
+//
+// The start and the end are separated by a solidus, or by a double hyphen if the
+// layout contains a solidus. The end may be abbreviated, in which case the fields
+// missing in the end are taken from the start. The trailing zone of the start is
+// also used when the end omits it, e.g. 2022-06-20T09:00+08:00/10:30.
+func ParseInterval(value string, l *Layout) (Interval, error) {
+	var (
+		iv  Interval
+		err error
+		sep = intervalSeparator(l)
+	)
+	i := strings.Index(value, sep)
+	if i < 0 {
+		return Interval{}, &ParseError{Layout: l.layout + sep + l.layout, Value: value, LayoutElem: sep, ValueElem: value}
+	}
+	start, end := value[:i], value[i+len(sep):]
+	if isPeriod(start) {
+		iv.Form = IntervalPeriodEnd
+		if iv.Period, err = ParsePeriod(start); err != nil {
+			return Interval{}, err
+		}
+		if iv.End, err = l.Parse(end); err != nil {
+			return Interval{}, err
+		}
+		iv.Start = iv.Period.Negate().AddTo(iv.End)
+		return iv, nil
+	}
+	offsets := make([]int, len(l.args))
+	f, err := l.parseOffsets(start, offsets)
+	if err != nil {
+		return Interval{}, err
+	}
+	if iv.Start, err = l.resolve(&f, start, time.UTC); err != nil {
+		return Interval{}, err
+	}
+	if isPeriod(end) {
+		iv.Form = IntervalStartPeriod
+		if iv.Period, err = ParsePeriod(end); err != nil {
+			return Interval{}, err
+		}
+		iv.End = iv.Period.AddTo(iv.Start)
+		return iv, nil
+	}
+	if iv.End, err = l.Parse(end); err != nil {
+		// the end without the leading fields of the start, which starts at a field,
+		// and may be without the trailing zone of the start
+		iv.Form = IntervalAbbreviatedEnd
+		zone := ""
+		if i := l.trailingZone(); i < len(l.args) {
+			zone = start[offsets[i]:]
+		}
+		for i := 0; i < len(l.args); i++ {
+			if i > 0 && l.args[i].ph.flag == formatFlagNone {
+				continue
+			}
+			if i > 0 {
+				if iv.End, err = l.Parse(start[:offsets[i]] + end); err == nil {
+					break
+				}
+			}
+			if zone != "" {
+				if iv.End, err = l.Parse(start[:offsets[i]] + end + zone); err == nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return Interval{}, &ParseError{Layout: l.layout, Value: value, LayoutElem: l.layout, ValueElem: end}
+		}
+	}
+	if iv.End.Before(iv.Start) {
+		return Interval{}, &ParseError{Layout: l.layout, Value: value, Message: ": " + errIntervalOrder.Error()}
+	}
+	return iv, nil
+}
+
+// trailingZone returns the index of the first arg of the zone fields ending the
+// layout, along with the text before them, or len(l.args) if there are none.
+func (l *Layout) trailingZone() int {
+	i, zone := len(l.args), len(l.args)
+	for i > 0 && (l.args[i-1].ph.flag == formatFlagNone || l.args[i-1].ph.flag.Has(formatFlagNeedZone)) {
+		i--
+		if l.args[i].ph.flag != formatFlagNone {
+			zone = i
+		}
+	}
+	if zone == len(l.args) {
+		return zone
+	}
+	return i
+}
+
+// isPeriod reports whether the value starts like an ISO 8601 duration.
+func isPeriod(value string) bool {
+	if len(value) > 0 && (value[0] == '-' || value[0] == '+') {
+		value = value[1:]
+	}
+	return len(value) > 1 && value[0] == 'P' && (isDigit(value[1]) || value[1] == 'T' || value[1] == '-')
+}
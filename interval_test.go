@@ -0,0 +1,105 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleParseInterval() {
+	l := datefmt.NewLayout("yyyy-MM-dd'T'HH:mmX")
+	iv, _ := datefmt.ParseInterval("2022-06-20T09:00Z/PT1H", l)
+	fmt.Println(iv.Start, "-", iv.End)
+	fmt.Println(datefmt.FormatInterval(datefmt.NewInterval(iv.Start, iv.End), l))
+
+	iv, _ = datefmt.ParseInterval("2022-06-20/06-25", datefmt.NewLayout("yyyy-MM-dd"))
+	fmt.Println(iv.End)
+	// Output:
+	// 2022-06-20 09:00:00 +0000 UTC - 2022-06-20 10:00:00 +0000 UTC
+	// 2022-06-20T09:00Z/2022-06-20T10:00Z
+	// 2022-06-25 00:00:00 +0000 UTC
+}
+
+func TestFormatInterval(t *testing.T) {
+	var (
+		start = time.Date(2022, time.June, 20, 9, 0, 0, 0, time.UTC)
+		day   = time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)
+		p     = datefmt.Period{Hours: 1, Minutes: 30}
+	)
+	testCases := []struct {
+		layout string
+		iv     datefmt.Interval
+		out    string
+	}{
+		{layout: "yyyy-MM-dd'T'HH:mmX", iv: datefmt.NewInterval(start, start.Add(time.Hour)), out: "2022-06-20T09:00Z/2022-06-20T10:00Z"},
+		{layout: "yyyy-MM-dd'T'HH:mmX", iv: datefmt.NewStartPeriodInterval(start, p), out: "2022-06-20T09:00Z/PT1H30M"},
+		{layout: "yyyy-MM-dd'T'HH:mmX", iv: datefmt.NewPeriodEndInterval(p, start), out: "PT1H30M/2022-06-20T09:00Z"},
+		{layout: "yyyy-MM-dd'T'HH:mm", iv: datefmt.Interval{Start: start, End: start.Add(90 * time.Minute), Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20T09:00/10:30"},
+		{layout: "yyyy-MM-dd'T'HH:mm", iv: datefmt.Interval{Start: start, End: start.AddDate(0, 0, 1), Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20T09:00/21T09:00"},
+		{layout: "yyyy-MM-dd", iv: datefmt.Interval{Start: day, End: day.AddDate(0, 0, 5), Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20/25"},
+		{layout: "yyyy-MM-dd", iv: datefmt.Interval{Start: day, End: day.AddDate(0, 1, 0), Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20/07-20"},
+		{layout: "yyyy-MM-dd", iv: datefmt.Interval{Start: day, End: day.AddDate(1, 0, 0), Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20/2023-06-20"},
+		{layout: "yyyy-MM-dd", iv: datefmt.Interval{Start: day, End: day, Form: datefmt.IntervalAbbreviatedEnd}, out: "2022-06-20/2022-06-20"},
+		{layout: "dd/MM/yyyy", iv: datefmt.NewInterval(day, day.AddDate(0, 0, 5)), out: "20/06/2022--25/06/2022"},
+	}
+	for _, tc := range testCases {
+		l := datefmt.NewLayout(tc.layout)
+		if out := datefmt.FormatInterval(tc.iv, l); out != tc.out {
+			t.Errorf("FormatInterval(%+v) with %q = %q, want %q", tc.iv, tc.layout, out, tc.out)
+			continue
+		}
+		iv, err := datefmt.ParseInterval(tc.out, l)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) with %q: %v", tc.out, tc.layout, err)
+			continue
+		}
+		if !iv.Start.Equal(tc.iv.Start) || !iv.End.Equal(tc.iv.End) || iv.Period != tc.iv.Period {
+			t.Errorf("ParseInterval(%q) with %q = %+v, want %+v", tc.out, tc.layout, iv, tc.iv)
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	testCases := []struct {
+		layout     string
+		value      string
+		start, end time.Time
+		form       datefmt.IntervalForm
+		err        bool
+	}{
+		{layout: "yyyy-MM-dd", value: "2022-06-20/06-25", start: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 25, 0, 0, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-MM-dd", value: "2022-01-31/P1M", start: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC), end: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC), form: datefmt.IntervalStartPeriod},
+		{layout: "yyyy-MM-dd", value: "P1D/2022-06-20", start: time.Date(2022, time.June, 19, 0, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), form: datefmt.IntervalPeriodEnd},
+		{layout: "yyyy-MM-dd'T'HH:mmXXX", value: "2022-06-20T09:00+08:00/10:30+08:00", start: time.Date(2022, time.June, 20, 1, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 20, 2, 30, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-MM-dd'T'HH:mmXXX", value: "2022-06-20T09:00+08:00/10:30", start: time.Date(2022, time.June, 20, 1, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 20, 2, 30, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-MM-dd'T'HH:mmXXX", value: "2022-06-20T09:00+08:00/2022-06-21T10:30", start: time.Date(2022, time.June, 20, 1, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 21, 2, 30, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-MM-dd HH:mm z", value: "2022-06-20 09:00 PDT/10:30", start: time.Date(2022, time.June, 20, 16, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 20, 17, 30, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-M-d", value: "2022-6-9/12", start: time.Date(2022, time.June, 9, 0, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 12, 0, 0, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-M-d", value: "2022-6-9/12-1", start: time.Date(2022, time.June, 9, 0, 0, 0, 0, time.UTC), end: time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-M-d H:mm", value: "2022-6-9 9:00/10:30", start: time.Date(2022, time.June, 9, 9, 0, 0, 0, time.UTC), end: time.Date(2022, time.June, 9, 10, 30, 0, 0, time.UTC), form: datefmt.IntervalAbbreviatedEnd},
+		{layout: "yyyy-MM-dd", value: "2022-06-20", err: true},
+		{layout: "yyyy-MM-dd", value: "2022-06-20/2022-06-19", err: true},
+		{layout: "yyyy-MM-dd", value: "2022-06-20/19", err: true},
+		{layout: "yyyy-MM-dd", value: "2022-06-20/x", err: true},
+		{layout: "yyyy-MM-dd", value: "2022-06-20/P1X", err: true},
+		{layout: "yyyy-MM-dd", value: "P1D/PT1H", err: true},
+	}
+	for _, tc := range testCases {
+		iv, err := datefmt.ParseInterval(tc.value, datefmt.NewLayout(tc.layout))
+		if tc.err {
+			if err == nil {
+				t.Errorf("ParseInterval(%q) with %q = %+v, want error", tc.value, tc.layout, iv)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseInterval(%q) with %q: %v", tc.value, tc.layout, err)
+			continue
+		}
+		if !iv.Start.Equal(tc.start) || !iv.End.Equal(tc.end) || iv.Form != tc.form {
+			t.Errorf("ParseInterval(%q) with %q = %+v, want %v - %v", tc.value, tc.layout, iv, tc.start, tc.end)
+		}
+	}
+}
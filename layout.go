@@ -8,9 +8,11 @@ import (
 
 type Layout struct {
 	max    int
+	years  int
 	flag   formatFlag
 	args   []*formatArg
 	layout string
+	opts   options
 }
 
 func (l *Layout) String() string {
@@ -27,12 +29,23 @@ func (l *Layout) Format(t time.Time) string {
 		second     int
 		zoneName   string
 		zoneOffset int
+		date       CalendarDate
 		p          []byte
 	)
 
-	p = make([]byte, 0, l.max)
 	if l.flag.Has(formatFlagNeedDate) {
 		year, month, day = t.Date()
+	} else if l.years > 0 {
+		year = t.Year()
+	}
+	if l.flag.Has(formatFlagNeedCalendar) {
+		date = l.opts.calendar.Date(t)
+	}
+	if l.years > 0 && (year < 1 || year > 9998) {
+		// reserve space for years (or week years) with more than 4 digits or a sign
+		p = make([]byte, 0, l.max+l.years*(yearDigitsMax-4))
+	} else {
+		p = make([]byte, 0, l.max)
 	}
 	if l.flag.Has(formatFlagNeedClock) {
 		hour, minute, second = t.Clock()
@@ -41,6 +54,7 @@ func (l *Layout) Format(t time.Time) string {
 		zoneName, zoneOffset = t.Zone()
 	}
 	for _, arg := range l.args {
+		start := len(p)
 		switch arg.ph.flag {
 		case formatFlagNone:
 			p = arg.ph.format(p, 0, arg.w)
@@ -56,12 +70,20 @@ func (l *Layout) Format(t time.Time) string {
 			p = arg.ph.format(p, minute, arg.w)
 		case formatFlagSecond:
 			p = arg.ph.format(p, second, arg.w)
+		case formatFlagDayPeriod:
+			p = arg.ph.format(p, hour*60+minute, arg.w)
 		case formatFlagWeekDay:
 			p = arg.ph.format(p, int(t.Weekday()), arg.w)
 		case formatFlagNanosecond:
 			p = arg.ph.format(p, t.Nanosecond(), arg.w)
 		case formatFlagZoneName:
-			p = formatZoneName(p, zoneName, zoneOffset)
+			if arg.w >= 4 {
+				p = l.formatZone(p, t, arg)
+			} else {
+				p = formatZoneName(p, zoneName, zoneOffset)
+			}
+		case formatFlagZone:
+			p = l.formatZone(p, t, arg)
 		case formatFlagZoneOffset:
 			p = arg.ph.format(p, zoneOffset, arg.w)
 		case formatFlagYearDay:
@@ -86,15 +108,46 @@ func (l *Layout) Format(t time.Time) string {
 				_, lastWeek := time.Date(year, time.December, 28, 0, 0, 0, 0, t.Location()).ISOWeek()
 				p = arg.ph.format(p, lastWeek-firstWeek+2, arg.w)
 			}
+		case formatFlagCalendarEra:
+			p = append(p, l.opts.calendar.EraName(date.Era, eraNameWidth(arg.w))...)
+		case formatFlagCalendarYear:
+			p = arg.ph.format(p, date.Year, arg.w)
+		case formatFlagCalendarMonth:
+			if arg.w >= 3 {
+				p = append(p, l.opts.calendar.MonthName(date, monthNameWidth(arg.w))...)
+			} else {
+				start := len(p)
+				p = arg.ph.format(p, date.Month, arg.w)
+				if cyc, ok := l.opts.calendar.(cyclicCalendar); ok && date.LeapMonth {
+					p = append(p[:start], cyc.leapMonth(string(p[start:]))...)
+				}
+			}
+		case formatFlagCalendarDay:
+			p = arg.ph.format(p, date.Day, arg.w)
+		case formatFlagCalendarYearDay:
+			p = arg.ph.format(p, date.YearDay, arg.w)
+		case formatFlagCalendarCyclicYear:
+			p = append(p, l.opts.calendar.(cyclicCalendar).cyclicYearName(date)...)
+		case formatFlagCalendarRelatedYear:
+			p = arg.ph.format(p, l.opts.calendar.(cyclicCalendar).relatedYear(date), arg.w)
+		case formatFlagCalendarDayName:
+			if name := l.opts.calendar.(cyclicCalendar).dayName(date); name != "" {
+				p = append(p, name...)
+			} else {
+				p = formatMax99(p, uint(date.Day))
+			}
+		}
+		if l.opts.digits != nil && arg.localized() {
+			p = l.opts.digits.localize(p, start, arg.positional())
 		}
 	}
 	// fmt.Println("len =", fb.Len(), ", cap =", fb.Cap(), ", max =", l.max)
 	return readOnlyBytes2String(p)
 }
 
-func NewLayout(generalLayout string) *Layout {
+func NewLayout(generalLayout string, opts ...Option) *Layout {
 	var (
-		l    = Layout{layout: generalLayout}
+		l    = Layout{layout: generalLayout, opts: defaultOptions}
 		gl   = []byte(generalLayout)
 		n    = len(gl)
 		sb   = strings.Builder{}
@@ -107,9 +160,16 @@ func NewLayout(generalLayout string) *Layout {
 		sb.Reset()
 		sb.Grow(tmax)
 	}
+	for _, opt := range opts {
+		opt(&l.opts)
+	}
+	if l.opts.calendar != nil {
+		l.opts.calendar = localizeCalendar(l.opts.calendar, l.opts.locale)
+	}
+	names := l.opts.locale.calendarNames("gregorian")
 	sb.Grow(tmax)
 	for i := 0; i < n; i++ {
-		if _, ok := placeholders[gl[i]]; !ok && gl[i] != '\'' {
+		if !l.isPlaceholder(gl[i]) && gl[i] != '\'' {
 			sb.WriteByte(gl[i])
 			continue
 		}
@@ -151,19 +211,88 @@ func NewLayout(generalLayout string) *Layout {
 			e = i
 		}
 		// fmt.Println("ph =", string(gl[s:e+1]))
-		arg := newPlaceholderFormatArg(gl[s : e+1])
+		var arg *formatArg
+		if l.opts.calendar != nil {
+			arg = newCalendarFormatArg(gl[s:e+1], l.opts.calendar)
+		} else {
+			arg = newPlaceholderFormatArg(gl[s : e+1])
+		}
+		if names != nil && !arg.ph.flag.Has(formatFlagNeedCalendar) {
+			localizeFormatArg(arg, names)
+		}
+		if arg.ph.flag == formatFlagDayPeriod {
+			if data := l.opts.dayPeriodData(token); data != dayPeriodsEN {
+				localizeDayPeriodArg(arg, data)
+			}
+		}
+		if i+1 < n && gl[i+1] == 'o' && strings.IndexByte(ordinalPlaceholders, token) >= 0 && arg.numeric() {
+			// ordinal modifier
+			rules := l.opts.locale.ordinals()
+			if rules == ordinalsEN && l.opts.digits != nil {
+				// the English suffixes do not follow other digits, e.g. 二十 rather than 二十th
+				rules = nil
+			}
+			ordinalFormatArg(arg, rules)
+			i++
+		}
 		l.args = append(l.args, arg)
 		l.max += arg.max
 		l.flag.Add(arg.ph.flag)
+		if arg.year() {
+			l.years++
+		}
 	}
 	// flush buffer
 	if sb.Len() > 0 {
 		// fmt.Println("text =", sb.String())
 		flushBuffer()
 	}
+	if l.opts.calendar == nil && l.hasEra() {
+		// the year is the year of era under G, otherwise the proleptic year
+		for _, arg := range l.args {
+			if arg.s[0] == 'y' {
+				arg.ph.format = formatYearOfEra
+			}
+		}
+	}
 	return &l
 }
 
+// hasEra reports whether the layout has an era field.
+func (l *Layout) hasEra() bool {
+	for _, arg := range l.args {
+		if arg.ph.flag != formatFlagNone && arg.s[0] == 'G' {
+			return true
+		}
+	}
+	return false
+}
+
+// subLayout returns the layout of the args from i to j.
+func (l *Layout) subLayout(i, j int) *Layout {
+	sub := Layout{args: l.args[i:j], layout: l.layout, opts: l.opts}
+	for _, arg := range sub.args {
+		sub.max += arg.max
+		sub.flag.Add(arg.ph.flag)
+		if arg.year() {
+			sub.years++
+		}
+	}
+	return &sub
+}
+
+// isPlaceholder reports whether c is a placeholder letter of the layout.
+func (l *Layout) isPlaceholder(c byte) bool {
+	if _, ok := placeholders[c]; ok {
+		return true
+	}
+	if l.opts.calendar != nil {
+		_, ok := calendarPlaceholders[c]
+		return ok
+	}
+	return false
+}
+
 type formatArg struct {
 	s   string
 	w   int
@@ -193,6 +322,19 @@ func newPlaceholderFormatArg(p []byte) *formatArg {
 	}
 }
 
+// year reports whether the arg is a year which may have more than 4 digits.
+func (arg *formatArg) year() bool {
+	switch arg.ph.flag {
+	case formatFlagYear, formatFlagWeekYear, formatFlagCalendarYear, formatFlagCalendarRelatedYear:
+		return true
+	}
+	return false
+}
+
+func (arg *formatArg) numeric() bool {
+	return arg.ph.numeric != nil && arg.ph.numeric(arg.w)
+}
+
 type formatFlag int
 
 const (
@@ -211,13 +353,25 @@ const (
 	formatFlagHour formatFlag = iota + formatFlagNeedClock
 	formatFlagMinute
 	formatFlagSecond
+	formatFlagDayPeriod
 
 	formatFlagZoneName formatFlag = iota + formatFlagNeedZone
 	formatFlagZoneOffset
-
-	formatFlagNeedDate  formatFlag = 1 << 0 << 7
-	formatFlagNeedClock formatFlag = 1 << 1 << 7
-	formatFlagNeedZone  formatFlag = 1 << 2 << 7
+	formatFlagZone // names, GMT formats and IDs, see formatZone
+
+	formatFlagCalendarEra formatFlag = iota + formatFlagNeedCalendar
+	formatFlagCalendarYear
+	formatFlagCalendarMonth
+	formatFlagCalendarDay
+	formatFlagCalendarYearDay
+	formatFlagCalendarCyclicYear
+	formatFlagCalendarRelatedYear
+	formatFlagCalendarDayName
+
+	formatFlagNeedDate     formatFlag = 1 << 0 << 7
+	formatFlagNeedClock    formatFlag = 1 << 1 << 7
+	formatFlagNeedZone     formatFlag = 1 << 2 << 7
+	formatFlagNeedCalendar formatFlag = 1 << 3 << 7
 )
 
 func (f *formatFlag) Add(flag formatFlag) {
@@ -229,45 +383,56 @@ func (f formatFlag) Has(flag formatFlag) bool {
 }
 
 type placeholder struct {
-	max    func(int) int
-	flag   formatFlag
-	format func(p []byte, v, w int) []byte
+	max     func(int) int
+	flag    formatFlag
+	format  func(p []byte, v, w int) []byte
+	parse   func(ps *parser, value string, w int) (string, error)
+	numeric func(int) bool
 }
 
 var (
 	placeholders = map[byte]*placeholder{
-		'G': {max: fixedMax(2), flag: formatFlagYear, format: formatEra},
-		'y': {max: yearMax, flag: formatFlagYear, format: formatYear},
-		'Y': {max: yearMax, flag: formatFlagWeekYear, format: formatYear},
-		'M': {max: monthMax, flag: formatFlagMonth, format: formatMonth},
-		'w': {max: numberMax(2), flag: formatFlagWeekInYear, format: formatNumProbably2Digits},
-		'W': {max: numberMax(2), flag: formatFlagWeekInMonth, format: formatNumProbably2Digits},
-		'D': {max: numberMax(3), flag: formatFlagYearDay, format: formatNumProbably3Digits},
-		'd': {max: numberMax(2), flag: formatFlagDay, format: formatNumProbably2Digits},
-		'F': {max: numberMax(1), flag: formatFlagDay, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, dayOfWeekInMonth(v), w) }},
-		'E': {max: textMax(3, 9), flag: formatFlagWeekDay, format: formatWeek},
-		'u': {max: numberMax(1), flag: formatFlagWeekDay, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, dayNumOfWeek(v), w) }},
-		'a': {max: fixedMax(2), flag: formatFlagHour, format: formatPM},
-		'H': {max: numberMax(2), flag: formatFlagHour, format: formatNumProbably2Digits},
-		'k': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, hour24(v), w) }},
-		'K': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, v%12, w) }},
-		'h': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, hour12(v), w) }},
-		'm': {max: numberMax(2), flag: formatFlagMinute, format: formatNumProbably2Digits},
-		's': {max: numberMax(2), flag: formatFlagSecond, format: formatNumProbably2Digits},
-		'S': {max: nanosecondMax, flag: formatFlagNanosecond, format: formatNanosecond},
-		'z': {max: fixedMax(5), flag: formatFlagZoneName},
-		'Z': {max: fixedMax(5), flag: formatFlagZoneOffset, format: formatZoneOffsetRFC822},
-		'X': {max: fixedMax(6), flag: formatFlagZoneOffset, format: formatZoneOffsetISO8601},
+		'G': {max: fixedMax(2), flag: formatFlagYear, format: formatEra, parse: parseEra},
+		'y': {max: yearMax, flag: formatFlagYear, format: formatYear, parse: parseYear, numeric: anyWidth},
+		'r': {max: extendedYearMax, flag: formatFlagYear, format: formatExtendedYear, parse: parseExtendedYear, numeric: anyWidth},
+		'Y': {max: yearMax, flag: formatFlagWeekYear, format: formatYear, parse: parseWeekYear, numeric: anyWidth},
+		'M': {max: monthMax, flag: formatFlagMonth, format: formatMonth, parse: parseMonth, numeric: widthBelow(3)},
+		'w': {max: numberMax(2), flag: formatFlagWeekInYear, format: formatNumProbably2Digits, parse: parseNum(FieldWeek), numeric: anyWidth},
+		'W': {max: numberMax(2), flag: formatFlagWeekInMonth, format: formatNumProbably2Digits, parse: parseNum(FieldWeekInMonth), numeric: anyWidth},
+		'D': {max: numberMax(3), flag: formatFlagYearDay, format: formatNumProbably3Digits, parse: parseNum(FieldYearDay), numeric: anyWidth},
+		'd': {max: numberMax(2), flag: formatFlagDay, format: formatNumProbably2Digits, parse: parseNum(FieldDay), numeric: anyWidth},
+		'F': {max: numberMax(1), flag: formatFlagDay, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, dayOfWeekInMonth(v), w) }, parse: parseNum(FieldDayOfWeekInMonth), numeric: anyWidth},
+		'E': {max: textMax(3, 9), flag: formatFlagWeekDay, format: formatWeek, parse: parseWeek},
+		'u': {max: numberMax(1), flag: formatFlagWeekDay, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, dayNumOfWeek(v), w) }, parse: parseDayNumOfWeek, numeric: anyWidth},
+		'a': {max: fixedMax(2), flag: formatFlagHour, format: formatPM, parse: parsePM},
+		'b': {max: fixedMax(8), flag: formatFlagDayPeriod, format: formatDayPeriod, parse: parseDayPeriod},
+		'B': {max: fixedMax(16), flag: formatFlagDayPeriod, format: formatFlexibleDayPeriod, parse: parseDayPeriod},
+		'H': {max: numberMax(2), flag: formatFlagHour, format: formatNumProbably2Digits, parse: parseHour, numeric: anyWidth},
+		'k': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, hour24(v), w) }, parse: parseHour24, numeric: anyWidth},
+		'K': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, v%12, w) }, parse: parseHour11, numeric: anyWidth},
+		'h': {max: numberMax(2), flag: formatFlagHour, format: func(p []byte, v, w int) []byte { return formatNumProbably2Digits(p, hour12(v), w) }, parse: parseHour12, numeric: anyWidth},
+		'm': {max: numberMax(2), flag: formatFlagMinute, format: formatNumProbably2Digits, parse: parseNum(FieldMinute), numeric: anyWidth},
+		's': {max: numberMax(2), flag: formatFlagSecond, format: formatNumProbably2Digits, parse: parseNum(FieldSecond), numeric: anyWidth},
+		'S': {max: nanosecondMax, flag: formatFlagNanosecond, format: formatNanosecond, parse: parseNanosecond, numeric: anyWidth},
+		'z': {max: zoneNameMax, flag: formatFlagZoneName, parse: parseZoneName},
+		'Z': {max: fixedMax(5), flag: formatFlagZoneOffset, format: formatZoneOffsetRFC822, parse: parseZoneOffsetRFC822},
+		'X': {max: fixedMax(6), flag: formatFlagZoneOffset, format: formatZoneOffsetISO8601, parse: parseZoneOffsetISO8601},
+		'v': {max: zoneNameMax, flag: formatFlagZone, parse: parseZone},
+		'O': {max: fixedMax(9), flag: formatFlagZone, parse: parseZoneGMT},
+		'V': {max: zoneNameMax, flag: formatFlagZone, parse: parseZoneID},
 	}
 )
 
 // y Y Year
 
+// yearDigitsMax is the max length of a year, including the sign
+const yearDigitsMax = 21
+
 func yearMax(w int) int {
 	if w == 2 {
 		return 2
 	}
-	return 4
+	return numberMax(4)(w)
 }
 
 func formatYear(p []byte, year, w int) []byte {
@@ -277,6 +442,27 @@ func formatYear(p []byte, year, w int) []byte {
 	return formatNumProbably4Digits(p, year, w)
 }
 
+func formatYearOfEra(p []byte, year, w int) []byte {
+	return formatYear(p, yearOfEra(year), w)
+}
+
+// r Extended year
+
+func extendedYearMax(w int) int {
+	return numberMax(4)(w) + 1
+}
+
+func formatExtendedYear(p []byte, year, w int) []byte {
+	if w >= 5 || year > 9999 {
+		// ISO 8601 expanded representation
+		if year >= 0 {
+			p = append(p, '+')
+		}
+		return formatNum(p, year, w)
+	}
+	return formatNumProbably4Digits(p, year, w)
+}
+
 // M Month
 
 func monthMax(w int) int {
@@ -311,7 +497,7 @@ func formatWeek(p []byte, week, w int) []byte {
 // G Era
 
 func formatEra(p []byte, year, w int) []byte {
-	if year < 0 {
+	if year <= 0 {
 		return append(p, "BC"...)
 	}
 	return append(p, "AD"...)
@@ -333,6 +519,14 @@ func nanosecondMax(w int) int {
 }
 
 func formatNanosecond(p []byte, v, w int) []byte {
+	if w > 9 {
+		// no more precision than nanosecond
+		p = formatNum(p, v, 9)
+		for ; w > 9; w-- {
+			p = append(p, '0')
+		}
+		return p
+	}
 	// truncate nanosecond number
 	div := 1000000000
 	i := w
@@ -345,6 +539,13 @@ func formatNanosecond(p []byte, v, w int) []byte {
 
 // z Zone name
 
+func zoneNameMax(w int) int {
+	if w >= 4 {
+		return 32
+	}
+	return 5
+}
+
 func formatZoneName(p []byte, zoneName string, zoneOffset int) []byte {
 	if len(zoneName) > 0 {
 		return append(p, zoneName...)
@@ -380,6 +581,14 @@ func formatZoneOffsetISO8601(p []byte, zoneOffset, w int) []byte {
 
 // helper functions
 
+// yearOfEra converts a proleptic year to the year of era, the year 0 is 1 BC.
+func yearOfEra(year int) int {
+	if year <= 0 {
+		return 1 - year
+	}
+	return year
+}
+
 func hour12(hour int) int {
 	hour %= 12
 	if hour == 0 {
@@ -443,6 +652,16 @@ func numberMax(minWidth int) func(int) int {
 	}
 }
 
+func anyWidth(int) bool {
+	return true
+}
+
+func widthBelow(max int) func(int) bool {
+	return func(w int) bool {
+		return w < max
+	}
+}
+
 func readOnlyBytes2String(b []byte) string {
 	return *(*string)(unsafe.Pointer(&b))
 }
@@ -2,6 +2,7 @@ package datefmt_test
 
 import (
 	"fmt"
+	"testing"
 	"time"
 
 	"github.com/Nomango/datefmt"
@@ -16,3 +17,24 @@ func ExampleLayout() {
 	// Output:
 	// yyyy-MM-dd HH:mm:ss = 2022-06-20 21:49:10
 }
+
+func TestLayoutFormatAllocs(t *testing.T) {
+	testCases := []struct {
+		layout string
+		in     time.Time
+	}{
+		{layout: "yyyy-MM-dd", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyyy-MM-dd", in: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{layout: "yyyy-MM-dd", in: time.Date(292277026596, time.December, 4, 0, 0, 0, 0, time.UTC)},
+		{layout: "rrrr YYYY yy", in: time.Date(-292277022399, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{layout: "rrrrrr-MM-dd", in: time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{layout: "YYYY-'W'ww", in: time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{layout: "ss.SSSSSSSSSSSS", in: time.Date(2022, time.June, 20, 0, 0, 0, 181999999, time.UTC)},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout)
+		if n := testing.AllocsPerRun(10, func() { _ = l.Format(c.in) }); n > 1 {
+			t.Errorf("Format(%s, %s) allocates %v times; want 1", c.layout, l.Format(c.in), n)
+		}
+	}
+}
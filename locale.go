@@ -0,0 +1,300 @@
+package datefmt
+
+//go:generate go run ./cmd/datefmt-cldrgen -cldr testdata/cldr -locales ja,zh -builtin en -out .
+
+import (
+	"strings"
+	"time"
+)
+
+// Locale is a BCP 47 language tag, e.g. "en", "he" or "zh-CN".
+// Names missing in a locale fall back to its parent locale, e.g. "zh-CN" to "zh",
+// and finally to the built-in English names.
+type Locale string
+
+type localeData struct {
+	calendars map[string]*calendarNames // by calendar ID
+	relative  [3]*relativeNames         // by NameWidth
+	skeletons map[string]string         // patterns by skeleton
+	dateTime  string                    // pattern joining a date {1} and a time {0}
+	hour      byte                      // preferred hour letter of the skeleton letter j
+	styles    *stylePatterns
+	ordinals  *ordinalRules
+	periods   *dayPeriodData
+	zones     *zoneNames
+}
+
+// calendarNames is the localized names of a calendar.
+type calendarNames struct {
+	eras   [3][]string // short, long and narrow names
+	months [3][]string // short, long and narrow names
+
+	// leapYearMonths is the names of months which are different in leap years,
+	// e.g. Adar II in the Hebrew calendar.
+	leapYearMonths map[int][3]string
+
+	// The names of calendars with cyclic years, see cyclicCalendar.
+	leapMonthPattern string // e.g. 闰{0}
+	cyclicYears      []string
+	days             []string
+
+	// The names of the Gregorian calendar, see localizeFormatArg.
+	weekdays   [3][]string // short, long and narrow names from Sunday
+	dayPeriods [3][]string // short, long and narrow names of am and pm
+}
+
+// parent returns the parent locale, or an empty locale if there is none.
+func (loc Locale) parent() Locale {
+	if i := strings.LastIndexAny(string(loc), "-_"); i >= 0 {
+		return loc[:i]
+	}
+	return ""
+}
+
+// calendarNames returns the names of the calendar with id in the locale or its parents.
+func (loc Locale) calendarNames(id string) *calendarNames {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok {
+			if names, ok := data.calendars[id]; ok {
+				return names
+			}
+		}
+	}
+	return nil
+}
+
+// registerLocale adds the data to the locale, the data already in the locale is kept.
+func registerLocale(loc Locale, data *localeData) {
+	dst, ok := locales[loc]
+	if !ok {
+		locales[loc] = data
+		return
+	}
+	for id, names := range data.calendars {
+		if dst.calendars == nil {
+			dst.calendars = map[string]*calendarNames{}
+		}
+		if _, ok := dst.calendars[id]; !ok {
+			dst.calendars[id] = names
+		}
+	}
+	for width, names := range data.relative {
+		if dst.relative[width] == nil {
+			dst.relative[width] = names
+		}
+	}
+	if dst.skeletons == nil {
+		dst.skeletons = data.skeletons
+	}
+	if dst.dateTime == "" {
+		dst.dateTime = data.dateTime
+	}
+	if dst.hour == 0 {
+		dst.hour = data.hour
+	}
+	if dst.styles == nil {
+		dst.styles = data.styles
+	}
+	if dst.ordinals == nil {
+		dst.ordinals = data.ordinals
+	}
+	if dst.periods == nil {
+		dst.periods = data.periods
+	}
+	if dst.zones == nil {
+		dst.zones = data.zones
+	}
+}
+
+// localizeFormatArg replaces the English names of the era, month, weekday and
+// am/pm marker in arg with the names of the Gregorian calendar.
+func localizeFormatArg(arg *formatArg, names *calendarNames) {
+	var (
+		all   [3][]string
+		width = eraNameWidth(arg.w)
+		set   func(f *Fields, i int)
+	)
+	switch arg.s[0] {
+	case 'G':
+		all = names.eras
+		set = func(f *Fields, i int) {
+			f.Era = i
+			f.present |= FieldEra
+		}
+	case 'M':
+		if arg.w < 3 {
+			return
+		}
+		all, width = names.months, monthNameWidth(arg.w)
+		set = func(f *Fields, i int) {
+			f.Month = i + 1
+			f.present |= FieldMonth
+		}
+	case 'E':
+		all = names.weekdays
+		set = func(f *Fields, i int) {
+			f.Weekday = time.Weekday(i)
+			f.present |= FieldWeekday
+		}
+	case 'a':
+		all = names.dayPeriods
+		set = func(f *Fields, i int) {
+			f.PM = i == 1
+			f.present |= FieldPM
+		}
+	default:
+		return
+	}
+	display := all[width]
+	if len(display) == 0 {
+		return
+	}
+	arg.max = 0
+	for _, name := range display {
+		arg.max = maxInt(arg.max, len(name))
+	}
+	arg.ph.format = func(p []byte, v, _ int) []byte {
+		switch arg.s[0] {
+		case 'G':
+			v = boolToInt(v > 0)
+		case 'M':
+			v--
+		case 'a':
+			v = boolToInt(v >= 12)
+		}
+		return append(p, display[v]...)
+	}
+	arg.ph.parse = func(ps *parser, value string, _ int) (string, error) {
+		// the longest name of any width
+		idx, rest := -1, value
+		for _, names := range all {
+			if i, r, ok := lookupName(value, names); ok && len(r) < len(rest) {
+				idx, rest = i, r
+			}
+		}
+		if idx < 0 {
+			return value, errBad
+		}
+		set(&ps.f, idx)
+		return rest, nil
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// localizeCalendar returns cal with the names in loc.
+func localizeCalendar(cal Calendar, loc Locale) Calendar {
+	names := loc.calendarNames(cal.String())
+	if names == nil {
+		return cal
+	}
+	lc := &localizedCalendar{Calendar: cal, names: names}
+	if cyc, ok := cal.(cyclicCalendar); ok {
+		return &localizedCyclicCalendar{localizedCalendar: lc, cyc: cyc}
+	}
+	return lc
+}
+
+// leapYearCalendar is implemented by calendars whose month names depend on leap years.
+type leapYearCalendar interface {
+	isLeapYear(d CalendarDate) bool
+}
+
+// leapAndCommonYears returns the first leap year and the first common year of cal.
+func leapAndCommonYears(cal leapYearCalendar) []int {
+	var leap, common int
+	for year := 1; year <= 60 && (leap == 0 || common == 0); year++ {
+		if cal.isLeapYear(CalendarDate{Year: year}) {
+			if leap == 0 {
+				leap = year
+			}
+		} else if common == 0 {
+			common = year
+		}
+	}
+	if leap == 0 {
+		return []int{common}
+	}
+	return []int{leap, common}
+}
+
+type localizedCalendar struct {
+	Calendar
+	names *calendarNames
+}
+
+func (c *localizedCalendar) EraName(era int, width NameWidth) string {
+	if names := c.names.eras[width]; era >= 0 && era < len(names) {
+		return names[era]
+	}
+	return c.Calendar.EraName(era, width)
+}
+
+func (c *localizedCalendar) isLeapYear(d CalendarDate) bool {
+	cal, ok := c.Calendar.(leapYearCalendar)
+	return ok && cal.isLeapYear(d)
+}
+
+func (c *localizedCalendar) gregorianMonths() bool {
+	cal, ok := c.Calendar.(gregorianMonthCalendar)
+	return ok && cal.gregorianMonths()
+}
+
+func (c *localizedCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if leap, ok := c.names.leapYearMonths[d.Month]; ok {
+		if c.isLeapYear(d) {
+			return leap[width]
+		}
+	}
+	if names := c.names.months[width]; d.Month >= 1 && d.Month <= len(names) {
+		return names[d.Month-1]
+	}
+	return c.Calendar.MonthName(d, width)
+}
+
+type localizedCyclicCalendar struct {
+	*localizedCalendar
+	cyc cyclicCalendar
+}
+
+func (c *localizedCyclicCalendar) MonthName(d CalendarDate, width NameWidth) string {
+	if !d.LeapMonth {
+		return c.localizedCalendar.MonthName(d, width)
+	}
+	d.LeapMonth = false
+	return c.leapMonth(c.localizedCalendar.MonthName(d, width))
+}
+
+func (c *localizedCyclicCalendar) cyclicYearName(d CalendarDate) string {
+	if len(c.names.cyclicYears) == 60 {
+		return c.names.cyclicYears[mod(d.Year-1, 60)]
+	}
+	return c.cyc.cyclicYearName(d)
+}
+
+func (c *localizedCyclicCalendar) dayName(d CalendarDate) string {
+	if d.Day >= 1 && d.Day <= len(c.names.days) {
+		return c.names.days[d.Day-1]
+	}
+	return c.cyc.dayName(d)
+}
+
+func (c *localizedCyclicCalendar) leapMonth(month string) string {
+	if c.names.leapMonthPattern != "" {
+		return strings.Replace(c.names.leapMonthPattern, "{0}", month, 1)
+	}
+	return c.cyc.leapMonth(month)
+}
+
+func (c *localizedCyclicCalendar) relatedYear(d CalendarDate) int {
+	return c.cyc.relatedYear(d)
+}
+
+func (c *localizedCyclicCalendar) fromRelatedYear(year int) (era, yearOfEra int) {
+	return c.cyc.fromRelatedYear(year)
+}
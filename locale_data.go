@@ -0,0 +1,93 @@
+package datefmt
+
+var locales = map[Locale]*localeData{
+	"de": {
+		ordinals: &ordinalRules{patterns: []string{"{0}."}},
+	},
+	"fa": {
+		calendars: map[string]*calendarNames{
+			"persian": {
+				eras: [3][]string{{"ه‍.ش."}, {"هجری شمسی"}, {"ه‍.ش."}},
+				months: [3][]string{
+					{"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"},
+					{"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"},
+					{"ف", "ا", "خ", "ت", "م", "ش", "م", "آ", "آ", "د", "ب", "ا"},
+				},
+			},
+		},
+	},
+	"fr": {
+		ordinals: &ordinalRules{
+			patterns: []string{"{0}er", "{0}e"},
+			category: func(n int) int { return boolToInt(n != 1) },
+		},
+	},
+	"he": {
+		calendars: map[string]*calendarNames{
+			"hebrew": {
+				eras: [3][]string{{"לבה״ע"}, {"לבריאת העולם"}, {"לבה״ע"}},
+				months: [3][]string{
+					{"תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר א׳", "אדר", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול"},
+					{"תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר א׳", "אדר", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול"},
+					{"תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר א׳", "אדר", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול"},
+				},
+				leapYearMonths: map[int][3]string{7: {"אדר ב׳", "אדר ב׳", "אדר ב׳"}},
+			},
+		},
+	},
+	"zh": {
+		ordinals: &ordinalRules{patterns: []string{"第{0}"}},
+		relative: [3]*relativeNames{
+			NameShort: {
+				RelativeSecond: {future: [2]string{"", "{0}秒后"}, past: [2]string{"", "{0}秒前"}, text: map[int]string{0: "现在"}},
+				RelativeMinute: {future: [2]string{"", "{0}分钟后"}, past: [2]string{"", "{0}分钟前"}, text: map[int]string{0: "此刻"}},
+				RelativeHour:   {future: [2]string{"", "{0}小时后"}, past: [2]string{"", "{0}小时前"}, text: map[int]string{0: "这一时间"}},
+				RelativeDay:    {future: [2]string{"", "{0}天后"}, past: [2]string{"", "{0}天前"}, text: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"}},
+				RelativeWeek:   {future: [2]string{"", "{0}周后"}, past: [2]string{"", "{0}周前"}, text: map[int]string{-1: "上周", 0: "本周", 1: "下周"}},
+				RelativeMonth:  {future: [2]string{"", "{0}个月后"}, past: [2]string{"", "{0}个月前"}, text: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"}},
+				RelativeYear:   {future: [2]string{"", "{0}年后"}, past: [2]string{"", "{0}年前"}, text: map[int]string{-1: "去年", 0: "今年", 1: "明年"}},
+			},
+			NameLong: {
+				RelativeSecond: {future: [2]string{"", "{0}秒钟后"}, past: [2]string{"", "{0}秒钟前"}, text: map[int]string{0: "现在"}},
+				RelativeMinute: {future: [2]string{"", "{0}分钟后"}, past: [2]string{"", "{0}分钟前"}, text: map[int]string{0: "此刻"}},
+				RelativeHour:   {future: [2]string{"", "{0}小时后"}, past: [2]string{"", "{0}小时前"}, text: map[int]string{0: "这一时间"}},
+				RelativeDay:    {future: [2]string{"", "{0}天后"}, past: [2]string{"", "{0}天前"}, text: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"}},
+				RelativeWeek:   {future: [2]string{"", "{0}周后"}, past: [2]string{"", "{0}周前"}, text: map[int]string{-1: "上周", 0: "本周", 1: "下周"}},
+				RelativeMonth:  {future: [2]string{"", "{0}个月后"}, past: [2]string{"", "{0}个月前"}, text: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"}},
+				RelativeYear:   {future: [2]string{"", "{0}年后"}, past: [2]string{"", "{0}年前"}, text: map[int]string{-1: "去年", 0: "今年", 1: "明年"}},
+			},
+			NameNarrow: {
+				RelativeSecond: {future: [2]string{"", "{0}秒后"}, past: [2]string{"", "{0}秒前"}, text: map[int]string{0: "现在"}},
+				RelativeMinute: {future: [2]string{"", "{0}分钟后"}, past: [2]string{"", "{0}分钟前"}, text: map[int]string{0: "此刻"}},
+				RelativeHour:   {future: [2]string{"", "{0}小时后"}, past: [2]string{"", "{0}小时前"}, text: map[int]string{0: "这一时间"}},
+				RelativeDay:    {future: [2]string{"", "{0}天后"}, past: [2]string{"", "{0}天前"}, text: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"}},
+				RelativeWeek:   {future: [2]string{"", "{0}周后"}, past: [2]string{"", "{0}周前"}, text: map[int]string{-1: "上周", 0: "本周", 1: "下周"}},
+				RelativeMonth:  {future: [2]string{"", "{0}个月后"}, past: [2]string{"", "{0}个月前"}, text: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"}},
+				RelativeYear:   {future: [2]string{"", "{0}年后"}, past: [2]string{"", "{0}年前"}, text: map[int]string{-1: "去年", 0: "今年", 1: "明年"}},
+			},
+		},
+		calendars: map[string]*calendarNames{
+			"chinese": {
+				months: [3][]string{
+					{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"},
+					{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"},
+					{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+				},
+				leapMonthPattern: "闰{0}",
+				cyclicYears: []string{
+					"甲子", "乙丑", "丙寅", "丁卯", "戊辰", "己巳", "庚午", "辛未", "壬申", "癸酉",
+					"甲戌", "乙亥", "丙子", "丁丑", "戊寅", "己卯", "庚辰", "辛巳", "壬午", "癸未",
+					"甲申", "乙酉", "丙戌", "丁亥", "戊子", "己丑", "庚寅", "辛卯", "壬辰", "癸巳",
+					"甲午", "乙未", "丙申", "丁酉", "戊戌", "己亥", "庚子", "辛丑", "壬寅", "癸卯",
+					"甲辰", "乙巳", "丙午", "丁未", "戊申", "己酉", "庚戌", "辛亥", "壬子", "癸丑",
+					"甲寅", "乙卯", "丙辰", "丁巳", "戊午", "己未", "庚申", "辛酉", "壬戌", "癸亥",
+				},
+				days: []string{
+					"初一", "初二", "初三", "初四", "初五", "初六", "初七", "初八", "初九", "初十",
+					"十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九", "二十",
+					"廿一", "廿二", "廿三", "廿四", "廿五", "廿六", "廿七", "廿八", "廿九", "三十",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,58 @@
+package datefmt_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+// generatedLocales reports whether each generated locale is built, see the
+// cldr_*_test.go files, which are built along with the locales they test.
+var generatedLocales = map[datefmt.Locale]bool{"ja": false, "zh": false}
+
+// builtLocale reports whether the data of the locale is built. Generated locales
+// are left out with the build tag datefmt_select unless their tags are given.
+func builtLocale(loc datefmt.Locale) bool {
+	if i := strings.IndexAny(string(loc), "-_"); i >= 0 {
+		loc = loc[:i]
+	}
+	built, generated := generatedLocales[loc]
+	return built || !generated
+}
+
+func TestLocalizedNames(t *testing.T) {
+	tm := time.Date(2022, time.June, 20, 21, 49, 10, 0, time.UTC)
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		out    string
+		parse  bool
+	}{
+		{layout: "G y MMM d EEE a h:mm", locale: "zh", out: "公元 2022 6月 20 周一 下午 9:49", parse: true},
+		{layout: "MMMM EEEE", locale: "zh-CN", out: "六月 星期一"},
+		{layout: "G y MMM d EEE a h:mm", locale: "ja-JP", out: "西暦 2022 6月 20 月 午後 9:49", parse: true},
+		{layout: "GGGGG y EEEE", locale: "ja", out: "AD 2022 月曜日"},
+		{layout: "G y MMM d EEE a h:mm", locale: "fr", out: "AD 2022 Jun 20 Mon PM 9:49", parse: true},
+	}
+	for _, c := range testCases {
+		if !builtLocale(c.locale) {
+			continue
+		}
+		l := datefmt.NewLayout(c.layout, datefmt.WithLocale(c.locale))
+		if out := l.Format(tm); out != c.out {
+			t.Errorf("Format(%q, %q) = %q, want %q", c.layout, c.locale, out, c.out)
+			continue
+		}
+		if !c.parse {
+			continue
+		}
+		parsed, err := l.Parse(c.out)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, c.out, err)
+		} else if want := tm.Truncate(time.Minute); !parsed.Equal(want) {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, c.out, parsed, want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package datefmt
+
+// Option configures a Layout created by NewLayout, or FormatRelative.
+// The options of FormatRelative are WithLocale, WithDigits and the WithRelative
+// options, which do not apply to layouts.
+type Option func(*options)
+
+type options struct {
+	twoDigitYearStart int
+	calendar          Calendar
+	locale            Locale
+	digits            *Digits // nil for the ASCII digits
+	dayPeriods        []DayPeriod
+
+	relativeStyle      RelativeStyle
+	relativeWidth      NameWidth
+	relativeThresholds [RelativeYear]int
+}
+
+var defaultOptions = options{
+	twoDigitYearStart:  1969,
+	relativeWidth:      NameLong,
+	relativeThresholds: defaultRelativeThresholds,
+}
+
+// WithTwoDigitYearStart sets the first year of the century window that two-digit
+// years are parsed into by yy and YY. The default window is 1969-2068 like the time package.
+//
+// Use WithTwoDigitYearStart(time.Now().Year() - 80) for the window of Java,
+// which is 80 years before and 20 years after now.
+func WithTwoDigitYearStart(year int) Option {
+	return func(o *options) {
+		o.twoDigitYearStart = year
+	}
+}
+
+// WithCalendar sets the calendar system of the era, year, month and day fields,
+// which are G, y, M, d, D and F. The other fields are always in the Gregorian calendar.
+// It does not apply to FormatRelative.
+func WithCalendar(cal Calendar) Option {
+	return func(o *options) {
+		o.calendar = cal
+	}
+}
+
+// WithLocale sets the locale of the names in the layout, e.g. the month names
+// of the Hebrew calendar with WithLocale("he").
+func WithLocale(loc Locale) Option {
+	return func(o *options) {
+		o.locale = loc
+	}
+}
+
+// WithDigits sets the digits of the numeric fields, e.g. WithDigits(ArabicIndicDigits)
+// formats 2022 as ٢٠٢٢. Parsing accepts both the digits and the ASCII digits.
+// FormatRelative formats its number with the digits.
+func WithDigits(digits *Digits) Option {
+	return func(o *options) {
+		if digits == LatinDigits {
+			digits = nil
+		}
+		o.digits = digits
+	}
+}
+
+// WithDayPeriods sets the flexible day periods of B instead of the periods of
+// the locale, e.g. WithDayPeriods(DayPeriod{"lunch", 12 * time.Hour, 14 * time.Hour})
+// formats 12:30 as "12:30 lunch" with "h:mm B". The am/pm marker of the locale is
+// used out of the periods.
+func WithDayPeriods(periods ...DayPeriod) Option {
+	return func(o *options) {
+		o.dayPeriods = periods
+	}
+}
+
+// dayPeriodData returns the day periods of the placeholder b or B.
+func (o *options) dayPeriodData(c byte) *dayPeriodData {
+	data := o.locale.dayPeriods()
+	if c == 'B' && o.dayPeriods != nil {
+		data = newDayPeriodData(o.dayPeriods, data)
+	}
+	return data
+}
+
+// WithRelativeStyle sets the style of FormatRelative, the default is RelativeNumeric.
+// It does not apply to layouts.
+func WithRelativeStyle(style RelativeStyle) Option {
+	return func(o *options) {
+		o.relativeStyle = style
+	}
+}
+
+// WithRelativeWidth sets the width of the units of FormatRelative, the default is NameLong.
+// It does not apply to layouts.
+func WithRelativeWidth(width NameWidth) Option {
+	return func(o *options) {
+		o.relativeWidth = width
+	}
+}
+
+// WithRelativeThreshold sets the max value of unit in FormatRelative,
+// the larger values are formatted in the next unit. The defaults are
+// 45 seconds, 45 minutes, 22 hours, 7 days, 4 weeks and 11 months.
+// e.g. WithRelativeThreshold(RelativeDay, 31) formats 20 days ago instead of
+// 3 weeks ago, and 0 skips the unit. RelativeYear has no threshold.
+// It does not apply to layouts.
+func WithRelativeThreshold(unit RelativeUnit, max int) Option {
+	return func(o *options) {
+		if unit >= RelativeSecond && unit < RelativeYear {
+			o.relativeThresholds[unit] = max
+		}
+	}
+}
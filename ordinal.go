@@ -0,0 +1,105 @@
+package datefmt
+
+import (
+	"strings"
+)
+
+// The ordinal modifier o follows a day in month, day in year or week in year,
+// e.g. do for 20th and 1st in English, see ordinalPlaceholders.
+
+// ordinalPlaceholders is the placeholders which may be followed by the ordinal modifier.
+const ordinalPlaceholders = "dDw"
+
+// ordinalRules is the ordinal patterns of a locale, e.g. {0}st for 1st.
+type ordinalRules struct {
+	patterns []string
+	// category returns the index of the pattern of n, nil for the first pattern.
+	category func(n int) int
+}
+
+// ordinalsEN is the built-in English ordinals.
+var ordinalsEN = &ordinalRules{
+	patterns: []string{"{0}st", "{0}nd", "{0}rd", "{0}th"},
+	category: func(n int) int {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 == 2 && n%100 != 12:
+			return 1
+		case n%10 == 3 && n%100 != 13:
+			return 2
+		}
+		return 3
+	},
+}
+
+func (loc Locale) ordinals() *ordinalRules {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.ordinals != nil {
+			return data.ordinals
+		}
+	}
+	return ordinalsEN
+}
+
+func (r *ordinalRules) pattern(n int) string {
+	if r.category == nil {
+		return r.patterns[0]
+	}
+	return r.patterns[r.category(n)]
+}
+
+// ordinalFormatArg adds the ordinal prefix and suffix of the rules to the number of arg,
+// which is left as the plain number if rules is nil.
+func ordinalFormatArg(arg *formatArg, rules *ordinalRules) {
+	arg.s += "o"
+	if rules == nil {
+		return
+	}
+	var (
+		format   = arg.ph.format
+		parse    = arg.ph.parse
+		prefixes []string
+		suffixes []string
+	)
+	for _, pattern := range rules.patterns {
+		prefix, suffix := splitOrdinal(pattern)
+		arg.max = maxInt(arg.max, arg.ph.max(arg.w)+len(prefix)+len(suffix))
+		prefixes = append(prefixes, prefix)
+		suffixes = append(suffixes, suffix)
+	}
+	arg.ph.format = func(p []byte, v, w int) []byte {
+		prefix, suffix := splitOrdinal(rules.pattern(v))
+		if prefix == "" {
+			return append(format(p, v, w), suffix...)
+		}
+		var buf [8]byte
+		num := format(buf[:0], v, w)
+		p = append(p, prefix...)
+		p = append(p, num...)
+		return append(p, suffix...)
+	}
+	arg.ph.parse = func(ps *parser, value string, w int) (string, error) {
+		if _, rest, ok := lookupName(value, prefixes); ok {
+			value = rest
+		}
+		rest, err := parse(ps, value, w)
+		if err != nil {
+			return rest, err
+		}
+		// the suffix is optional, e.g. June 20, 2022 for MMMM do, y
+		if _, r, ok := lookupName(rest, suffixes); ok {
+			rest = r
+		}
+		return rest, nil
+	}
+}
+
+// splitOrdinal returns the text before and after the number in the pattern.
+func splitOrdinal(pattern string) (string, string) {
+	i := strings.Index(pattern, "{0}")
+	if i < 0 {
+		return "", pattern
+	}
+	return pattern[:i], pattern[i+3:]
+}
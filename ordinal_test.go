@@ -0,0 +1,124 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleNewLayout_ordinal() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.NewLayout("MMMM do, y").Format(t))
+	fmt.Println(datefmt.NewLayout("'the' Do 'day of' y").Format(t))
+	// Output:
+	// June 20th, 2022
+	// the 171st day of 2022
+}
+
+func TestOrdinal(t *testing.T) {
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		time   time.Time
+		out    string
+	}{
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), out: "June 1st, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC), out: "June 2nd, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 3, 0, 0, 0, 0, time.UTC), out: "June 3rd, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 11, 0, 0, 0, 0, time.UTC), out: "June 11th, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 12, 0, 0, 0, 0, time.UTC), out: "June 12th, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 13, 0, 0, 0, 0, time.UTC), out: "June 13th, 2022"},
+		{layout: "MMMM do, y", time: time.Date(2022, 6, 22, 0, 0, 0, 0, time.UTC), out: "June 22nd, 2022"},
+		{layout: "ddo MMM y", time: time.Date(2022, 6, 3, 0, 0, 0, 0, time.UTC), out: "03rd Jun 2022"},
+		{layout: "Do 'day of' y", time: time.Date(2022, 4, 21, 0, 0, 0, 0, time.UTC), out: "111th day of 2022"},
+		{layout: "Do 'day of' y", time: time.Date(2022, 4, 22, 0, 0, 0, 0, time.UTC), out: "112th day of 2022"},
+		{layout: "wo 'week of' Y", time: time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), out: "2nd week of 2022"},
+		{layout: "do MMMM y", locale: "fr", time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), out: "1er June 2022"},
+		{layout: "do MMMM y", locale: "fr-CA", time: time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC), out: "20e June 2022"},
+		{layout: "do M y", locale: "de", time: time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC), out: "20. 6 2022"},
+		{layout: "y年M月do天", locale: "zh", time: time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC), out: "2022年6月第20天"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithLocale(c.locale))
+		out := l.Format(c.time)
+		if out != c.out {
+			t.Errorf("Format(%q, %q) = %q, want %q", c.layout, c.locale, out, c.out)
+			continue
+		}
+		parsed, err := l.Parse(out)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, out, err)
+		} else if !parsed.Equal(c.time) {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, out, parsed, c.time)
+		}
+	}
+}
+
+func TestOrdinalParse(t *testing.T) {
+	l := datefmt.NewLayout("MMMM do, y")
+	want := time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"June 20th, 2022", "June 20TH, 2022", "June 20, 2022", "June 20st, 2022"} {
+		tm, err := l.Parse(value)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", value, err)
+		} else if !tm.Equal(want) {
+			t.Errorf("Parse(%q) = %v, want %v", value, tm, want)
+		}
+	}
+	if _, err := l.Parse("June 20xx, 2022"); err == nil {
+		t.Errorf("Parse(%q) succeeded, want error", "June 20xx, 2022")
+	}
+}
+
+func TestOrdinalLiteral(t *testing.T) {
+	tm := time.Date(2022, 6, 20, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		layout string
+		out    string
+	}{
+		{layout: "h 'o''clock'", out: "9 o'clock"},
+		{layout: "MMo", out: "06o"},
+		{layout: "yo", out: "2022o"},
+		{layout: "MMMM do", out: "June 20th"},
+	}
+	for _, c := range testCases {
+		if out := datefmt.NewLayout(c.layout).Format(tm); out != c.out {
+			t.Errorf("Format(%q) = %q, want %q", c.layout, out, c.out)
+		}
+	}
+}
+
+func TestOrdinalDigits(t *testing.T) {
+	tm := time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC)
+	l := datefmt.NewLayout("y年M月do天", datefmt.WithLocale("zh"), datefmt.WithDigits(datefmt.ChineseNumerals))
+	out := l.Format(tm)
+	if want := "二〇二二年六月第二十天"; out != want {
+		t.Fatalf("Format = %q, want %q", out, want)
+	}
+	if parsed, err := l.Parse(out); err != nil || !parsed.Equal(tm) {
+		t.Errorf("Parse(%q) = %v, %v, want %v", out, parsed, err, tm)
+	}
+
+	// the English suffixes are not mixed with other digits
+	testCases := []struct {
+		layout string
+		digits *datefmt.Digits
+		out    string
+	}{
+		{layout: "yyyy年M月do", digits: datefmt.ChineseNumerals, out: "二〇二二年六月二十"},
+		{layout: "MMMM do, y", digits: datefmt.ArabicIndicDigits, out: "June ٢٠, ٢٠٢٢"},
+		{layout: "MMMM do, y", digits: datefmt.LatinDigits, out: "June 20th, 2022"},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithDigits(c.digits))
+		out := l.Format(tm)
+		if out != c.out {
+			t.Errorf("Format(%q) with %s = %q, want %q", c.layout, c.digits, out, c.out)
+		}
+		if parsed, err := l.Parse(out); err != nil || !parsed.Equal(tm) {
+			t.Errorf("Parse(%q) with %s = %v, %v, want %v", out, c.digits, parsed, err, tm)
+		}
+	}
+}
@@ -0,0 +1,517 @@
+package datefmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parse parses a formatted string and returns the time value it represents.
+// Elements omitted from the layout are assumed to be zero or, when zero is impossible, one.
+// In the absence of a time zone indicator, Parse returns a time in UTC.
+func (l *Layout) Parse(value string) (time.Time, error) {
+	return l.ParseInLocation(value, time.UTC)
+}
+
+// ParseInLocation is like Parse but interprets the time as in the given location
+// when the value does not contain time zone information.
+func (l *Layout) ParseInLocation(value string, loc *time.Location) (time.Time, error) {
+	f, err := l.parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return l.resolve(&f, value, loc)
+}
+
+// ParseWithDefaults is like Parse but fills the fields missing in the value from ref.
+// Fields more significant than the most significant parsed one are taken from ref,
+// less significant ones are set to their minimum, e.g. "14:30" parsed with "HH:mm"
+// becomes 14:30 of the day of ref.
+// Weeks in year and days of week are taken in the ISO week of ref, e.g. "Mon"
+// parsed with "EEE" becomes the Monday of that week.
+// With the Japanese, Buddhist or ROC calendar, a missing era and year are those
+// of the date in the Gregorian year of ref, so "12月31日" parsed in April 2019
+// is in Reiwa 1 although ref is in Heisei 31.
+// The time is interpreted as in the location of ref when the value does not
+// contain time zone information.
+func (l *Layout) ParseWithDefaults(value string, ref time.Time) (time.Time, error) {
+	f, err := l.parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	f.fill(ref)
+	return l.resolve(&f, value, ref.Location())
+}
+
+// ParseError describes a problem parsing a time string.
+type ParseError struct {
+	Layout     string
+	Value      string
+	LayoutElem string
+	ValueElem  string
+	Message    string
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	if e.Message == "" {
+		return "parsing time " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout) +
+			": cannot parse " + strconv.Quote(e.ValueElem) + " as " + strconv.Quote(e.LayoutElem)
+	}
+	return "parsing time " + strconv.Quote(e.Value) + e.Message
+}
+
+var (
+	errBad         = errors.New("bad value for field")
+	errYearRange   = errors.New("year out of range")
+	errNoYear      = errors.New("missing year")
+	errMonthRange  = errors.New("month out of range")
+	errDayRange    = errors.New("day out of range")
+	errHourRange   = errors.New("hour out of range")
+	errMinuteRange = errors.New("minute out of range")
+	errSecondRange = errors.New("second out of range")
+)
+
+func (l *Layout) parse(value string) (Fields, error) {
+	return l.parseOffsets(value, nil)
+}
+
+// parseOffsets parses the value like parse, and stores the offset of each arg
+// in the value into offsets if it is not nil.
+func (l *Layout) parseOffsets(value string, offsets []int) (Fields, error) {
+	var (
+		ps   = parser{l: l, f: Fields{cal: l.opts.calendar}}
+		rest = value
+		err  error
+	)
+	for i, arg := range l.args {
+		if offsets != nil {
+			offsets[i] = len(value) - len(rest)
+		}
+		if arg.ph.flag == formatFlagNone {
+			if !strings.HasPrefix(rest, arg.s) {
+				return ps.f, &ParseError{Layout: l.layout, Value: value, LayoutElem: arg.s, ValueElem: rest}
+			}
+			rest = rest[len(arg.s):]
+			continue
+		}
+		// abutting numeric fields consume exactly as many digits as the placeholder width
+		ps.fixed = i+1 < len(l.args) && l.args[i+1].numeric()
+		if l.opts.digits != nil && arg.localized() {
+			rest, err = ps.parseDigits(arg, rest)
+		} else {
+			rest, err = arg.ph.parse(&ps, rest, arg.w)
+		}
+		if err != nil {
+			return ps.f, &ParseError{Layout: l.layout, Value: value, LayoutElem: arg.s, ValueElem: rest}
+		}
+	}
+	if len(rest) > 0 {
+		return ps.f, &ParseError{Layout: l.layout, Value: value, Message: ": extra text: " + strconv.Quote(rest)}
+	}
+	ps.resolveDayPeriod()
+	if ps.hour12 && ps.f.PM {
+		ps.f.Hour += 12
+	}
+	return ps.f, nil
+}
+
+func (l *Layout) resolve(f *Fields, value string, loc *time.Location) (time.Time, error) {
+	t, err := f.Time(loc)
+	if err != nil {
+		return time.Time{}, &ParseError{Layout: l.layout, Value: value, Message: ": " + err.Error()}
+	}
+	return t, nil
+}
+
+// parseDigits parses the arg in value with the digits of the layout.
+func (ps *parser) parseDigits(arg *formatArg, value string) (string, error) {
+	ascii, offsets := ps.l.opts.digits.delocalize(value)
+	rest, err := arg.ph.parse(ps, ascii, arg.w)
+	if err != nil {
+		return value, err
+	}
+	i := offsets[len(ascii)-len(rest)]
+	if i < 0 {
+		// within a positional number
+		return value, errBad
+	}
+	return value[i:], nil
+}
+
+type parser struct {
+	l      *Layout
+	f      Fields
+	fixed  bool
+	hour12 bool
+	period *dayPeriodRule // the parsed flexible day period, noon or midnight
+}
+
+// G Era
+
+func parseEra(ps *parser, value string, w int) (string, error) {
+	i, rest, ok := lookupName(value, eraNames)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Era = i
+	ps.f.present |= FieldEra
+	return rest, nil
+}
+
+// y Year
+
+func parseYear(ps *parser, value string, w int) (string, error) {
+	year, rest, err := ps.parseYearNum(value, w)
+	if err != nil {
+		return value, err
+	}
+	ps.f.Year = year
+	ps.f.present |= FieldYear
+	return rest, nil
+}
+
+// r Proleptic year
+
+func parseExtendedYear(ps *parser, value string, w int) (string, error) {
+	sign, rest := 1, value
+	if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+') {
+		if rest[0] == '-' {
+			sign = -1
+		}
+		rest = rest[1:]
+	}
+	year, n, ok := getNum(rest, w, ps.fixed)
+	if !ok {
+		return value, errBad
+	}
+	// store the year of era, so that an era parsed before the proleptic year
+	// does not change its meaning
+	year *= sign
+	ps.f.Era, ps.f.Year = 1, yearOfEra(year)
+	if year <= 0 {
+		ps.f.Era = 0
+	}
+	ps.f.present |= FieldEra | FieldYear
+	return rest[n:], nil
+}
+
+// Y Week year
+
+func parseWeekYear(ps *parser, value string, w int) (string, error) {
+	year, rest, err := ps.parseYearNum(value, w)
+	if err != nil {
+		return value, err
+	}
+	ps.f.WeekYear = year
+	ps.f.present |= FieldWeekYear
+	return rest, nil
+}
+
+func (ps *parser) parseYearNum(value string, w int) (int, string, error) {
+	sign, rest := 1, value
+	if w != 2 && len(rest) > 0 && rest[0] == '-' {
+		// proleptic years before 1 AD
+		sign, rest = -1, rest[1:]
+	}
+	year, n, ok := getNum(rest, w, ps.fixed)
+	if !ok {
+		return 0, value, errBad
+	}
+	if sign < 0 {
+		return -year, rest[n:], nil
+	}
+	if w == 2 && n == 2 {
+		// two-digit years are in the century window beginning at the start year
+		start := ps.l.opts.twoDigitYearStart
+		year += start - mod(start, 100)
+		if year < start {
+			year += 100
+		}
+	}
+	return year, rest[n:], nil
+}
+
+// M Month
+
+func parseMonth(ps *parser, value string, w int) (string, error) {
+	if w < 3 {
+		return parseNum(FieldMonth)(ps, value, w)
+	}
+	i, rest, ok := lookupName(value, longMonthNames)
+	if !ok {
+		i, rest, ok = lookupName(value, shortMonthNames)
+	}
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Month = i + 1
+	ps.f.present |= FieldMonth
+	return rest, nil
+}
+
+// E Week
+
+func parseWeek(ps *parser, value string, w int) (string, error) {
+	i, rest, ok := lookupName(value, longDayNames)
+	if !ok {
+		i, rest, ok = lookupName(value, shortDayNames)
+	}
+	if !ok {
+		return value, errBad
+	}
+	ps.f.Weekday = time.Weekday(i)
+	ps.f.present |= FieldWeekday
+	return rest, nil
+}
+
+// u Day number of week
+
+func parseDayNumOfWeek(ps *parser, value string, w int) (string, error) {
+	v, n, ok := getNum(value, w, ps.fixed)
+	if !ok || v < 1 || v > 7 {
+		return value, errBad
+	}
+	ps.f.Weekday = time.Weekday(v % 7)
+	ps.f.present |= FieldWeekday
+	return value[n:], nil
+}
+
+// a PM
+
+func parsePM(ps *parser, value string, w int) (string, error) {
+	i, rest, ok := lookupName(value, pmNames)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.PM = i == 1
+	ps.f.present |= FieldPM
+	return rest, nil
+}
+
+// H k K h Hour
+
+func parseHour(ps *parser, value string, w int) (string, error) {
+	v, n, ok := getNum(value, w, ps.fixed)
+	if !ok || v > 23 {
+		return value, errHourRange
+	}
+	ps.f.Hour, ps.hour12 = v, false
+	ps.f.present |= FieldHour
+	return value[n:], nil
+}
+
+func parseHour24(ps *parser, value string, w int) (string, error) {
+	v, n, ok := getNum(value, w, ps.fixed)
+	if !ok || v < 1 || v > 24 {
+		return value, errHourRange
+	}
+	ps.f.Hour, ps.hour12 = v%24, false
+	ps.f.present |= FieldHour
+	return value[n:], nil
+}
+
+func parseHour11(ps *parser, value string, w int) (string, error) {
+	v, n, ok := getNum(value, w, ps.fixed)
+	if !ok || v > 11 {
+		return value, errHourRange
+	}
+	ps.f.Hour, ps.hour12 = v, true
+	ps.f.present |= FieldHour
+	return value[n:], nil
+}
+
+func parseHour12(ps *parser, value string, w int) (string, error) {
+	v, n, ok := getNum(value, w, ps.fixed)
+	if !ok || v < 1 || v > 12 {
+		return value, errHourRange
+	}
+	ps.f.Hour, ps.hour12 = v%12, true
+	ps.f.present |= FieldHour
+	return value[n:], nil
+}
+
+// S Nanosecond
+
+func parseNanosecond(ps *parser, value string, w int) (string, error) {
+	_, n, ok := getNum(value, w, ps.fixed)
+	if !ok {
+		return value, errBad
+	}
+	ns := 0
+	for i := 0; i < 9; i++ {
+		ns *= 10
+		if i < n {
+			ns += int(value[i] - '0')
+		}
+	}
+	ps.f.Nanosecond = ns
+	ps.f.present |= FieldNanosecond
+	return value[n:], nil
+}
+
+// z Zone name
+
+func parseZoneName(ps *parser, value string, w int) (string, error) {
+	if w >= 4 {
+		return parseZone(ps, value, w)
+	}
+	if len(value) > 0 && (value[0] == '+' || value[0] == '-') {
+		// formatted as RFC822 because the zone name is unknown
+		return parseZoneOffsetRFC822(ps, value, w)
+	}
+	n := 0
+	for n < len(value) && isLetter(value[n]) {
+		n++
+	}
+	if n < 3 {
+		return value, errBad
+	}
+	if value[:n] == "GMT" && n < len(value) && (value[n] == '+' || value[n] == '-') {
+		sign := n
+		for n++; n < len(value) && isDigit(value[n]); n++ {
+		}
+		if v, err := strconv.Atoi(value[sign+1 : n]); err != nil || v > 23 {
+			return value, errBad
+		}
+	}
+	ps.f.ZoneName = value[:n]
+	ps.f.present |= FieldZoneName
+	return value[n:], nil
+}
+
+// Z Zone RFC822
+
+func parseZoneOffsetRFC822(ps *parser, value string, w int) (string, error) {
+	if len(value) < 5 || (value[0] != '+' && value[0] != '-') {
+		return value, errBad
+	}
+	hour, _, okHour := getNum(value[1:3], 2, true)
+	minute, _, okMinute := getNum(value[3:5], 2, true)
+	if !okHour || !okMinute {
+		return value, errBad
+	}
+	ps.setZoneOffset(value[0], hour, minute)
+	return value[5:], nil
+}
+
+// X Zone ISO8601
+
+func parseZoneOffsetISO8601(ps *parser, value string, w int) (string, error) {
+	if len(value) > 0 && value[0] == 'Z' {
+		ps.f.ZoneName = "UTC"
+		ps.f.ZoneOffset = 0
+		ps.f.present |= FieldZoneOffset
+		return value[1:], nil
+	}
+	n := 6
+	switch w {
+	case 1:
+		n = 3
+	case 2:
+		n = 5
+	}
+	if len(value) < n || (value[0] != '+' && value[0] != '-') {
+		return value, errBad
+	}
+	hour, _, okHour := getNum(value[1:3], 2, true)
+	minute, okMinute := 0, true
+	switch n {
+	case 5:
+		minute, _, okMinute = getNum(value[3:5], 2, true)
+	case 6:
+		minute, _, okMinute = getNum(value[4:6], 2, true)
+		okMinute = okMinute && value[3] == ':'
+	}
+	if !okHour || !okMinute {
+		return value, errBad
+	}
+	ps.setZoneOffset(value[0], hour, minute)
+	return value[n:], nil
+}
+
+func (ps *parser) setZoneOffset(sign byte, hour, minute int) {
+	ps.f.ZoneOffset = (hour*60 + minute) * 60
+	if sign == '-' {
+		ps.f.ZoneOffset = -ps.f.ZoneOffset
+	}
+	ps.f.present |= FieldZoneOffset
+}
+
+// helper functions
+
+func parseNum(field Field) func(ps *parser, value string, w int) (string, error) {
+	return func(ps *parser, value string, w int) (string, error) {
+		v, n, ok := getNum(value, w, ps.fixed)
+		if !ok {
+			return value, errBad
+		}
+		ps.f.setNum(field, v)
+		return value[n:], nil
+	}
+}
+
+// getNum parses the leading decimal number of value and returns its value and length.
+// If fixed is true, exactly w digits are consumed, otherwise all the leading digits are.
+func getNum(value string, w int, fixed bool) (int, int, bool) {
+	n := 0
+	for n < len(value) && isDigit(value[n]) && (!fixed || n < w) {
+		n++
+	}
+	if n == 0 || (fixed && n < w) || n > 18 {
+		return 0, 0, false
+	}
+	v := 0
+	for i := 0; i < n; i++ {
+		v = v*10 + int(value[i]-'0')
+	}
+	return v, n, true
+}
+
+// lookupName returns the index of the longest name that value starts with, ignoring ASCII case.
+func lookupName(value string, names []string) (int, string, bool) {
+	idx, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+			idx, length = i, len(name)
+		}
+	}
+	if idx < 0 {
+		return 0, value, false
+	}
+	return idx, value[length:], true
+}
+
+func mod(a, b int) int {
+	r := a % b
+	if r < 0 {
+		r += b
+	}
+	return r
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+var (
+	eraNames        = []string{"BC", "AD"}
+	pmNames         = []string{"AM", "PM"}
+	longMonthNames  = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	shortMonthNames = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	longDayNames    = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	shortDayNames   = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
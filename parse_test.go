@@ -0,0 +1,293 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleLayout_ParseWithDefaults() {
+	l := datefmt.NewLayout("HH:mm")
+	now := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	t, _ := l.ParseWithDefaults("14:30", now)
+	fmt.Println(t)
+	// Output:
+	// 2022-06-20 14:30:00 +0000 UTC
+}
+
+type parseTestCase struct {
+	in  string
+	out time.Time
+}
+
+var (
+	zoneCST = time.FixedZone("CST", int((8 * time.Hour).Seconds()))
+
+	parseTestCases = []struct {
+		layout    string
+		testCases []parseTestCase
+	}{
+		{
+			layout: "yyyy-MM-dd HH:mm:ss",
+			testCases: []parseTestCase{
+				{in: "2022-06-20 09:49:10", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)},
+				{in: "2022-6-2 9:4:1", out: time.Date(2022, time.June, 2, 9, 4, 1, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "G yyyy-MM-dd",
+			testCases: []parseTestCase{
+				{in: "AD 2022-06-20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "BC 0001-06-20", out: time.Date(0, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "BC 0044-03-15", out: time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "rrrr-MM-dd",
+			testCases: []parseTestCase{
+				{in: "2022-06-20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "0000-06-20", out: time.Date(0, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "-0043-03-15", out: time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "G y r",
+			testCases: []parseTestCase{
+				{in: "BC 2 -1", out: time.Date(-1, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				{in: "AD 2022 2022", out: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "rrrrrr-MM-dd",
+			testCases: []parseTestCase{
+				{in: "+002022-06-20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "-000043-03-15", out: time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)},
+				{in: "+12345678-01-01", out: time.Date(12345678, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "yyyy-MM-dd",
+			testCases: []parseTestCase{
+				{in: "12345-06-20", out: time.Date(12345, time.June, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "yyyyMMddHHmmss",
+			testCases: []parseTestCase{
+				{in: "20220620094910", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "yy-M-d",
+			testCases: []parseTestCase{
+				{in: "22-6-20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "69-6-20", out: time.Date(1969, time.June, 20, 0, 0, 0, 0, time.UTC)},
+				{in: "2022-6-20", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "EEE, d MMM yyyy h:mm a",
+			testCases: []parseTestCase{
+				{in: "Mon, 20 Jun 2022 9:49 PM", out: time.Date(2022, time.June, 20, 21, 49, 0, 0, time.UTC)},
+				{in: "Monday, 20 June 2022 12:00 am", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "yyyy DDD k:mm",
+			testCases: []parseTestCase{
+				{in: "2022 171 24:00", out: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "HH:mm:ss.SSS",
+			testCases: []parseTestCase{
+				{in: "09:49:10.181", out: time.Date(0, time.January, 1, 9, 49, 10, 181000000, time.UTC)},
+				{in: "09:49:10.1", out: time.Date(0, time.January, 1, 9, 49, 10, 100000000, time.UTC)},
+			},
+		},
+		{
+			layout: "yyyy-MM-dd'T'HH:mm:ssXXX",
+			testCases: []parseTestCase{
+				{in: "2022-06-20T09:49:10Z", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)},
+				{in: "2022-06-20T09:49:10+08:00", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.FixedZone("", 8*3600))},
+			},
+		},
+		{
+			layout: "yyyy-MM-dd HH:mm:ss z Z",
+			testCases: []parseTestCase{
+				{in: "2022-06-20 09:49:10 CST +0800", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, zoneCST)},
+				{in: "2022-06-20 09:49:10 UTC +0000", out: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)},
+			},
+		},
+		{
+			layout: "hh 'o''clock' a",
+			testCases: []parseTestCase{
+				{in: "03 o'clock PM", out: time.Date(0, time.January, 1, 15, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	parseErrorTestCases = []struct {
+		layout string
+		in     string
+	}{
+		{layout: "yyyy-MM-dd", in: "2022/06/20"},
+		{layout: "yyyy-MM-dd", in: "2022-13-20"},
+		{layout: "yyyy-MM-dd", in: "2022-02-29"},
+		{layout: "yyyy-MM-dd", in: "2022-06-20 09:49"},
+		{layout: "HH:mm", in: "24:00"},
+		{layout: "h:mm a", in: "9:49 XM"},
+		{layout: "yyyyMMdd", in: "2022620"},
+		{layout: "EEE", in: "Mo"},
+		{layout: "XXX", in: "+0800"},
+		{layout: "G y", in: "BC 0"},
+		{layout: "y G", in: "0 AD"},
+	}
+)
+
+func TestLayoutParse(t *testing.T) {
+	for _, tt := range parseTestCases {
+		l := datefmt.NewLayout(tt.layout)
+		for _, c := range tt.testCases {
+			r, err := l.Parse(c.in)
+			if err != nil {
+				t.Errorf("Parse(%s, %s) returns error: %v", tt.layout, c.in, err)
+				continue
+			}
+			if !r.Equal(c.out) || r.String() != c.out.String() {
+				t.Errorf("Parse(%s, %s) = %s; want %s", tt.layout, c.in, r, c.out)
+			}
+		}
+	}
+}
+
+func TestLayoutParseError(t *testing.T) {
+	for _, tt := range parseErrorTestCases {
+		_, err := datefmt.NewLayout(tt.layout).Parse(tt.in)
+		if err == nil {
+			t.Errorf("Parse(%s, %s) should return error", tt.layout, tt.in)
+		}
+	}
+}
+
+func TestLayoutParseBC(t *testing.T) {
+	// years are proleptic without an era, and years of era with it
+	for _, layout := range []string{"yyyy-MM-dd", "y-M-d", "G yyyy-MM-dd"} {
+		l := datefmt.NewLayout(layout)
+		for _, year := range []int{-1, 0, 1} {
+			in := time.Date(year, time.March, 4, 0, 0, 0, 0, time.UTC)
+			s := l.Format(in)
+			if r, err := l.Parse(s); err != nil || !r.Equal(in) {
+				t.Errorf("Parse(%s, %s) = %s, %v; want %s", layout, s, r, err, in)
+			}
+		}
+	}
+}
+
+func TestLayoutParseInLocation(t *testing.T) {
+	l := datefmt.NewLayout("yyyy-MM-dd HH:mm:ss z")
+	r, err := l.ParseInLocation("2022-06-20 09:49:10 CST", zoneCST)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, time.June, 20, 9, 49, 10, 0, zoneCST); !r.Equal(want) || r.Location() != zoneCST {
+		t.Errorf("ParseInLocation() = %s; want %s", r, want)
+	}
+}
+
+func TestLayoutParseWithDefaults(t *testing.T) {
+	ref := time.Date(2022, time.June, 20, 9, 49, 10, 181, zoneCST)
+	testCases := []struct {
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{layout: "HH:mm", in: "14:30", out: time.Date(2022, time.June, 20, 14, 30, 0, 0, zoneCST)},
+		{layout: "MM-dd", in: "12-25", out: time.Date(2022, time.December, 25, 0, 0, 0, 0, zoneCST)},
+		{layout: "dd HH", in: "01 08", out: time.Date(2022, time.June, 1, 8, 0, 0, 0, zoneCST)},
+		{layout: "mm:ss", in: "30:05", out: time.Date(2022, time.June, 20, 9, 30, 5, 0, zoneCST)},
+		{layout: "DDD", in: "001", out: time.Date(2022, time.January, 1, 0, 0, 0, 0, zoneCST)},
+		{layout: "yyyy", in: "2021", out: time.Date(2021, time.January, 1, 0, 0, 0, 0, zoneCST)},
+		{layout: "HH:mm X", in: "14:30 Z", out: time.Date(2022, time.June, 20, 14, 30, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		r, err := datefmt.NewLayout(c.layout).ParseWithDefaults(c.in, ref)
+		if err != nil {
+			t.Errorf("ParseWithDefaults(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) || r.Location() != c.out.Location() {
+			t.Errorf("ParseWithDefaults(%s, %s) = %s; want %s", c.layout, c.in, r, c.out)
+		}
+	}
+}
+
+func TestLayoutParseWithDefaultsWeek(t *testing.T) {
+	// 2024-12-31 is the Tuesday of the first week of the ISO week-year 2025
+	ref := time.Date(2024, time.December, 31, 9, 49, 10, 0, time.UTC)
+	testCases := []struct {
+		layout string
+		in     string
+		out    time.Time
+	}{
+		{layout: "'W'ww", in: "W01", out: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{layout: "'W'ww EEE", in: "W02 Fri", out: time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)},
+		{layout: "EEE", in: "Mon", out: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{layout: "EEE HH:mm", in: "Sun 14:30", out: time.Date(2025, time.January, 5, 14, 30, 0, 0, time.UTC)},
+	}
+	for _, c := range testCases {
+		r, err := datefmt.NewLayout(c.layout).ParseWithDefaults(c.in, ref)
+		if err != nil {
+			t.Errorf("ParseWithDefaults(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if !r.Equal(c.out) {
+			t.Errorf("ParseWithDefaults(%s, %s) = %s; want %s", c.layout, c.in, r, c.out)
+		}
+	}
+}
+
+func TestWithTwoDigitYearStart(t *testing.T) {
+	testCases := []struct {
+		start  int
+		layout string
+		in     string
+		out    int
+	}{
+		{start: 1969, layout: "yy", in: "68", out: 2068},
+		{start: 1969, layout: "yy", in: "69", out: 1969},
+		{start: 1942, layout: "yy", in: "41", out: 2041},
+		{start: 1942, layout: "yy", in: "42", out: 1942},
+		{start: 1900, layout: "yy", in: "99", out: 1999},
+		{start: 1900, layout: "yy", in: "00", out: 1900},
+		{start: 1800, layout: "yyyy-yy", in: "1805-05", out: 1805},
+		{start: -150, layout: "yy", in: "49", out: -51},
+		{start: -150, layout: "yy", in: "50", out: -150},
+		{start: 1800, layout: "yy", in: "1999", out: 1999},
+	}
+	for _, c := range testCases {
+		l := datefmt.NewLayout(c.layout, datefmt.WithTwoDigitYearStart(c.start))
+		r, err := l.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%s, %s) returns error: %v", c.layout, c.in, err)
+			continue
+		}
+		if r.Year() != c.out {
+			t.Errorf("Parse(%s, %s) with start %d = %d; want %d", c.layout, c.in, c.start, r.Year(), c.out)
+		}
+	}
+}
+
+func TestLayoutParseFormatted(t *testing.T) {
+	for _, tt := range formatTestCases {
+		l := datefmt.NewLayout(tt.layout)
+		for _, c := range tt.testCases {
+			s := l.Format(c.in)
+			if _, err := l.Parse(s); err != nil {
+				t.Errorf("Parse(%s, %s) returns error: %v", tt.layout, s, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package datefmt
+
+import (
+	"strings"
+	"time"
+)
+
+// Period is an amount of time in calendar units, like the ISO 8601 duration P1Y2M10DT2H30M.
+// The fields may be negative and are not normalized, e.g. 90 minutes stay 90 minutes.
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// periodLayout is the layout reported by ParseError.
+const periodLayout = "PnYnMnWnDTnHnMnS"
+
+// ParsePeriod parses an ISO 8601 duration like P1Y2M10DT2H30M.
+//
+// Weeks are converted to days, only the seconds may have a fraction,
+// which is separated by a dot or a comma. The whole period or each
+// of its values may be negative, e.g. -P1D or P-1DT12H.
+func ParsePeriod(value string) (Period, error) {
+	var (
+		p    Period
+		rest = value
+		neg  bool
+		n    int // number of values
+	)
+	if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+') {
+		neg = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if len(rest) == 0 || (rest[0] != 'P' && rest[0] != 'p') {
+		return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: "P", ValueElem: rest}
+	}
+	rest = rest[1:]
+
+	// the designators of each part in order
+	units := []struct {
+		designator byte
+		field      *int
+		multiplier int
+	}{
+		{'Y', &p.Years, 1},
+		{'M', &p.Months, 1},
+		{'W', &p.Days, 7},
+		{'D', &p.Days, 1},
+		{'T', nil, 0},
+		{'H', &p.Hours, 1},
+		{'M', &p.Minutes, 1},
+		{'S', &p.Seconds, 1},
+	}
+	timePart := false
+	for i := 0; len(rest) > 0; {
+		if rest[0] == 'T' || rest[0] == 't' {
+			if timePart {
+				return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: "T", ValueElem: rest}
+			}
+			timePart = true
+			i = 5
+			rest = rest[1:]
+			if len(rest) == 0 {
+				return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: "nH", ValueElem: rest}
+			}
+			continue
+		}
+		v, frac, r, ok := parsePeriodValue(rest)
+		if !ok {
+			return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: "n", ValueElem: rest}
+		}
+		d := upperASCII(r)
+		for i < len(units) && (units[i].designator != d || units[i].field == nil || (i >= 5) != timePart) {
+			i++
+		}
+		if i == len(units) || (frac >= 0 && units[i].designator != 'S') {
+			return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: periodLayout, ValueElem: rest}
+		}
+		*units[i].field += v * units[i].multiplier
+		if frac >= 0 {
+			if v < 0 || (v == 0 && rest[0] == '-') {
+				frac = -frac
+			}
+			p.Nanoseconds = frac
+		}
+		i++
+		n++
+		rest = r[1:]
+	}
+	if n == 0 {
+		return Period{}, &ParseError{Layout: periodLayout, Value: value, LayoutElem: "n", ValueElem: rest}
+	}
+	if neg {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// parsePeriodValue parses a signed integer with an optional fraction, which is -1 if absent.
+func parsePeriodValue(value string) (v, frac int, rest string, ok bool) {
+	neg := false
+	if len(value) > 0 && (value[0] == '-' || value[0] == '+') {
+		neg = value[0] == '-'
+		value = value[1:]
+	}
+	v, n, ok := getNum(value, 0, false)
+	if !ok {
+		return 0, 0, value, false
+	}
+	value = value[n:]
+	frac = -1
+	if len(value) > 0 && (value[0] == '.' || value[0] == ',') {
+		_, n, ok := getNum(value[1:], 0, false)
+		if !ok {
+			return 0, 0, value, false
+		}
+		frac = 0
+		for i := 0; i < 9; i++ {
+			frac *= 10
+			if i < n {
+				frac += int(value[1+i] - '0')
+			}
+		}
+		value = value[1+n:]
+	}
+	if len(value) == 0 {
+		return 0, 0, value, false
+	}
+	if neg {
+		v = -v
+	}
+	return v, frac, value, true
+}
+
+func upperASCII(s string) byte {
+	if c := s[0]; c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return s[0]
+}
+
+// String returns the ISO 8601 representation of the period, e.g. P1Y2M10DT2H30M.
+// The zero period is formatted as P0D.
+func (p Period) String() string {
+	if p == (Period{}) {
+		return "P0D"
+	}
+	b := make([]byte, 0, 64)
+	b = append(b, 'P')
+	b = appendPeriodValue(b, p.Years, 'Y')
+	b = appendPeriodValue(b, p.Months, 'M')
+	b = appendPeriodValue(b, p.Days, 'D')
+	sec, nsec := p.seconds()
+	if p.Hours == 0 && p.Minutes == 0 && sec == 0 && nsec == 0 {
+		return string(b)
+	}
+	b = append(b, 'T')
+	b = appendPeriodValue(b, p.Hours, 'H')
+	b = appendPeriodValue(b, p.Minutes, 'M')
+	if sec == 0 && nsec == 0 {
+		return string(b)
+	}
+	if nsec == 0 {
+		return string(appendPeriodValue(b, sec, 'S'))
+	}
+	if sec < 0 || nsec < 0 {
+		b = append(b, '-')
+		sec, nsec = -sec, -nsec
+	}
+	b = formatNum(b, sec, 1)
+	b = append(b, '.')
+	b = append(b, strings.TrimRight(string(formatNum(nil, nsec, 9)), "0")...)
+	return string(append(b, 'S'))
+}
+
+func appendPeriodValue(b []byte, v int, designator byte) []byte {
+	if v == 0 {
+		return b
+	}
+	return append(formatNum(b, v, 1), designator)
+}
+
+// seconds returns the seconds and nanoseconds of the period with the same sign.
+func (p Period) seconds() (sec, nsec int) {
+	sec = p.Seconds + p.Nanoseconds/int(time.Second)
+	nsec = p.Nanoseconds % int(time.Second)
+	if sec > 0 && nsec < 0 {
+		sec--
+		nsec += int(time.Second)
+	} else if sec < 0 && nsec > 0 {
+		sec++
+		nsec -= int(time.Second)
+	}
+	return sec, nsec
+}
+
+// Negate returns the period with all values negated.
+func (p Period) Negate() Period {
+	return Period{
+		Years:       -p.Years,
+		Months:      -p.Months,
+		Days:        -p.Days,
+		Hours:       -p.Hours,
+		Minutes:     -p.Minutes,
+		Seconds:     -p.Seconds,
+		Nanoseconds: -p.Nanoseconds,
+	}
+}
+
+// AddTo returns t plus the period.
+//
+// The years and months are added first, the day is clamped to the last day of
+// the resulting month, e.g. Jan 31 plus P1M is Feb 28 (or Feb 29 in a leap year)
+// rather than Mar 3 like time.AddDate. The days are then added in the calendar,
+// so P1D is the same wall clock time on the next day across daylight saving
+// transitions, and the time values are added as an elapsed duration at last.
+func (p Period) AddTo(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	months := int(month) - 1 + p.Months + p.Years*12
+	year += floorDiv(months, 12)
+	month = time.Month(mod(months, 12) + 1)
+	if days := daysIn(month, year); day > days {
+		day = days
+	}
+	t = time.Date(year, month, day+p.Days, hour, min, sec, t.Nanosecond(), t.Location())
+	return t.Add(time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanoseconds))
+}
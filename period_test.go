@@ -0,0 +1,138 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExamplePeriod() {
+	p, _ := datefmt.ParsePeriod("P1Y2M10DT2H30M")
+	fmt.Println(p.Years, p.Months, p.Days, p.Hours, p.Minutes)
+	fmt.Println(p)
+
+	t := time.Date(2022, time.January, 31, 9, 0, 0, 0, time.UTC)
+	fmt.Println(datefmt.Period{Months: 1}.AddTo(t))
+	// Output:
+	// 1 2 10 2 30
+	// P1Y2M10DT2H30M
+	// 2022-02-28 09:00:00 +0000 UTC
+}
+
+func TestParsePeriod(t *testing.T) {
+	testCases := []struct {
+		value string
+		p     datefmt.Period
+		s     string
+		err   bool
+	}{
+		{value: "P1Y2M10DT2H30M", p: datefmt.Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}},
+		{value: "P1Y", p: datefmt.Period{Years: 1}},
+		{value: "P1M", p: datefmt.Period{Months: 1}},
+		{value: "PT1M", p: datefmt.Period{Minutes: 1}},
+		{value: "P0D", p: datefmt.Period{}},
+		{value: "PT0S", p: datefmt.Period{}, s: "P0D"},
+		{value: "P2W", p: datefmt.Period{Days: 14}, s: "P14D"},
+		{value: "P1W3D", p: datefmt.Period{Days: 10}, s: "P10D"},
+		{value: "PT36H", p: datefmt.Period{Hours: 36}},
+		{value: "PT1.5S", p: datefmt.Period{Seconds: 1, Nanoseconds: 500000000}},
+		{value: "PT0,000000001S", p: datefmt.Period{Nanoseconds: 1}, s: "PT0.000000001S"},
+		{value: "PT-0.5S", p: datefmt.Period{Nanoseconds: -500000000}},
+		{value: "PT-1.25S", p: datefmt.Period{Seconds: -1, Nanoseconds: -250000000}},
+		{value: "-P1Y2M", p: datefmt.Period{Years: -1, Months: -2}, s: "P-1Y-2M"},
+		{value: "P-1DT12H", p: datefmt.Period{Days: -1, Hours: 12}},
+		{value: "+P1D", p: datefmt.Period{Days: 1}, s: "P1D"},
+		{value: "p1y2mt3h", p: datefmt.Period{Years: 1, Months: 2, Hours: 3}, s: "P1Y2MT3H"},
+		{value: "", err: true},
+		{value: "P", err: true},
+		{value: "PT", err: true},
+		{value: "P1DT", err: true},
+		{value: "1D", err: true},
+		{value: "P1D1Y", err: true},
+		{value: "P1Y1Y", err: true},
+		{value: "PT1D", err: true},
+		{value: "P1H", err: true},
+		{value: "PT1.5M", err: true},
+		{value: "PT1S2M", err: true},
+		{value: "P1", err: true},
+		{value: "P1DTT1H", err: true},
+		{value: "P1Dx", err: true},
+	}
+	for _, tc := range testCases {
+		p, err := datefmt.ParsePeriod(tc.value)
+		if tc.err {
+			if err == nil {
+				t.Errorf("ParsePeriod(%q) = %+v, want error", tc.value, p)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParsePeriod(%q): %v", tc.value, err)
+			continue
+		}
+		if p != tc.p {
+			t.Errorf("ParsePeriod(%q) = %+v, want %+v", tc.value, p, tc.p)
+		}
+		s := tc.s
+		if s == "" {
+			s = tc.value
+		}
+		if p.String() != s {
+			t.Errorf("ParsePeriod(%q).String() = %q, want %q", tc.value, p.String(), s)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	testCases := []struct {
+		p datefmt.Period
+		s string
+	}{
+		{p: datefmt.Period{Minutes: 90}, s: "PT90M"},
+		{p: datefmt.Period{Seconds: 2, Nanoseconds: -500000000}, s: "PT1.5S"},
+		{p: datefmt.Period{Seconds: -2, Nanoseconds: 500000000}, s: "PT-1.5S"},
+		{p: datefmt.Period{Nanoseconds: 3000000000}, s: "PT3S"},
+		{p: datefmt.Period{Days: 1, Seconds: 1, Nanoseconds: -1000000000}, s: "P1D"},
+	}
+	for _, tc := range testCases {
+		if s := tc.p.String(); s != tc.s {
+			t.Errorf("%+v.String() = %q, want %q", tc.p, s, tc.s)
+		}
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	testCases := []struct {
+		t   time.Time
+		p   string
+		out time.Time
+	}{
+		{t: time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC), p: "P1Y2M10DT2H30M", out: time.Date(2023, time.August, 30, 12, 19, 10, 0, time.UTC)},
+		{t: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC), p: "P1M", out: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), p: "P1M", out: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), p: "P1Y", out: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2022, time.March, 31, 0, 0, 0, 0, time.UTC), p: "-P1M", out: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2022, time.January, 15, 0, 0, 0, 0, time.UTC), p: "P-13M", out: time.Date(2020, time.December, 15, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC), p: "P1M1D", out: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{t: time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC), p: "PT-0.5S", out: time.Date(2022, time.June, 19, 23, 59, 59, 500000000, time.UTC)},
+		// daylight saving time starts on 2022-03-13 in New York
+		{t: time.Date(2022, time.March, 12, 12, 0, 0, 0, newYork), p: "P1D", out: time.Date(2022, time.March, 13, 12, 0, 0, 0, newYork)},
+		{t: time.Date(2022, time.March, 12, 12, 0, 0, 0, newYork), p: "PT24H", out: time.Date(2022, time.March, 13, 13, 0, 0, 0, newYork)},
+	}
+	for _, tc := range testCases {
+		p, err := datefmt.ParsePeriod(tc.p)
+		if err != nil {
+			t.Errorf("ParsePeriod(%q): %v", tc.p, err)
+			continue
+		}
+		if out := p.AddTo(tc.t); !out.Equal(tc.out) {
+			t.Errorf("%s.AddTo(%v) = %v, want %v", tc.p, tc.t, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package datefmt
+
+import (
+	"time"
+	"unicode"
+)
+
+// rangeSeparator separates the start and the end of a range.
+const rangeSeparator = " – "
+
+// rangeField is the granularity of a field in a range, the fields of the
+// greatest differing granularity and below are repeated for the end.
+type rangeField int
+
+const (
+	rangeFieldNone rangeField = iota
+	rangeFieldTime            // hour, minute, second and fraction are repeated together
+	rangeFieldAmPm
+	rangeFieldDay
+	rangeFieldMonth
+	rangeFieldYear
+	rangeFieldEra
+	rangeFieldZone
+)
+
+func (arg *formatArg) rangeField() rangeField {
+	switch arg.ph.flag {
+	case formatFlagNone:
+		return rangeFieldNone
+	case formatFlagNanosecond, formatFlagMinute, formatFlagSecond:
+		return rangeFieldTime
+	case formatFlagDayPeriod:
+		return rangeFieldAmPm
+	case formatFlagHour:
+		if arg.s[0] == 'a' {
+			return rangeFieldAmPm
+		}
+		return rangeFieldTime
+	case formatFlagMonth, formatFlagCalendarMonth:
+		return rangeFieldMonth
+	case formatFlagYear:
+		if arg.s[0] == 'G' {
+			return rangeFieldEra
+		}
+		return rangeFieldYear
+	case formatFlagWeekYear, formatFlagCalendarYear, formatFlagCalendarCyclicYear, formatFlagCalendarRelatedYear:
+		return rangeFieldYear
+	case formatFlagCalendarEra:
+		return rangeFieldEra
+	case formatFlagZoneName, formatFlagZoneOffset, formatFlagZone:
+		return rangeFieldZone
+	}
+	return rangeFieldDay
+}
+
+// unitAt reports whether the arg at i is a text of letters, which is the unit
+// of the field before it, e.g. 日 in d日.
+func (l *Layout) unitAt(i int) bool {
+	if i >= len(l.args) || l.args[i].ph.flag != formatFlagNone {
+		return false
+	}
+	for _, r := range l.args[i].s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatRange returns the range from start to end in the pattern of the skeleton
+// in the locale, e.g. yMMMd for "MMM d, y" in English, see BestPattern.
+// It returns a *SkeletonError if the skeleton is not valid; use Layout.FormatRange
+// for a range in a pattern.
+func FormatRange(start, end time.Time, skeleton string, loc Locale) (string, error) {
+	l, err := skeletonLayout(skeleton, loc)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatRange(start, end), nil
+}
+
+// FormatRange returns the range from start to end in the layout.
+// Like ICU's DateIntervalFormat, the fields above the greatest differing field are
+// formatted once, e.g. "Jun 20 – 25, 2022" or "9:00 – 10:30 AM".
+//
+// Hours, minutes and seconds are repeated together, as well as the whole date if
+// the month is numeric without a unit, e.g. M/d/y. If the date differs in a pattern with both date and time,
+// start and end are formatted in full.
+func (l *Layout) FormatRange(start, end time.Time) string {
+	return l.formatRange(start, end)
+}
+
+func (l *Layout) formatRange(start, end time.Time) string {
+	var (
+		diff        = rangeFieldNone
+		numericDate bool
+		hasTime     bool
+	)
+	for i, arg := range l.args {
+		field := arg.rangeField()
+		switch field {
+		case rangeFieldNone:
+			continue
+		case rangeFieldMonth:
+			// numeric dates with units like 2022年6月20日 are collapsed as usual
+			numericDate = numericDate || (arg.numeric() && !l.unitAt(i+1))
+		case rangeFieldTime, rangeFieldAmPm:
+			hasTime = true
+		}
+		if field > diff {
+			if sub := l.subLayout(i, i+1); sub.Format(start) != sub.Format(end) {
+				diff = field
+			}
+		}
+	}
+	if diff == rangeFieldNone {
+		return l.Format(start)
+	}
+	if numericDate && diff >= rangeFieldDay && diff <= rangeFieldYear {
+		diff = rangeFieldYear
+	}
+	if diff == rangeFieldZone || (hasTime && diff >= rangeFieldDay) {
+		return l.Format(start) + rangeSeparator + l.Format(end)
+	}
+
+	// the args from first to last are repeated for the end
+	first, last := -1, -1
+	for i, arg := range l.args {
+		if field := arg.rangeField(); field != rangeFieldNone && field <= diff {
+			if first < 0 {
+				first = i
+			}
+			last = i + 1
+		}
+	}
+	if l.unitAt(last) {
+		last++
+	}
+	repeated := l.subLayout(first, last)
+	return l.subLayout(0, first).Format(start) +
+		repeated.Format(start) + rangeSeparator + repeated.Format(end) +
+		l.subLayout(last, len(l.args)).Format(start)
+}
@@ -0,0 +1,87 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleFormatRange() {
+	start := time.Date(2022, time.June, 20, 9, 0, 0, 0, time.UTC)
+	s, _ := datefmt.FormatRange(start, start.AddDate(0, 0, 5), "yMMMd", "en")
+	fmt.Println(s)
+	s, _ = datefmt.FormatRange(start, start.Add(90*time.Minute), "hm", "en")
+	fmt.Println(s)
+	fmt.Println(datefmt.NewLayout("MMM d h:mm a").FormatRange(start, start.Add(90*time.Minute)))
+	// Output:
+	// Jun 20 – 25, 2022
+	// 9:00 – 10:30 AM
+	// Jun 20 9:00 – 10:30 AM
+}
+
+func TestFormatRange(t *testing.T) {
+	start := time.Date(2022, time.June, 20, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		end      time.Time
+		skeleton string
+		loc      datefmt.Locale
+		out      string
+	}{
+		{end: start.Add(time.Hour), skeleton: "yMMMd", out: "Jun 20, 2022"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMMMd", out: "Jun 20 – 25, 2022"},
+		{end: start.AddDate(0, 1, 0), skeleton: "yMMMd", out: "Jun 20 – Jul 20, 2022"},
+		{end: start.AddDate(1, 0, 0), skeleton: "yMMMd", out: "Jun 20, 2022 – Jun 20, 2023"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMMMEd", out: "Mon, Jun 20 – Sat, Jun 25, 2022"},
+		{end: start.AddDate(0, 1, 0), skeleton: "yMMM", out: "Jun – Jul 2022"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMMM", out: "Jun 2022"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMd", out: "6/20/2022 – 6/25/2022"},
+		{end: start.AddDate(0, 0, 5), skeleton: "Md", out: "6/20 – 6/25"},
+		{end: start.Add(90 * time.Minute), skeleton: "hm", out: "9:00 – 10:30 AM"},
+		{end: start.Add(30 * time.Minute), skeleton: "hm", out: "9:00 – 9:30 AM"},
+		{end: start.Add(4 * time.Hour), skeleton: "hm", out: "9:00 AM – 1:00 PM"},
+		{end: start.Add(30 * time.Second), skeleton: "hm", out: "9:00 AM"},
+		{end: start.Add(90 * time.Minute), skeleton: "Hm", out: "09:00 – 10:30"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMMMd", loc: "zh-CN", out: "2022年6月20日 – 25日"},
+		{end: start.AddDate(0, 1, 0), skeleton: "yMMMd", loc: "zh-CN", out: "2022年6月20日 – 7月20日"},
+		{end: start.AddDate(0, 0, 5), skeleton: "yMd", loc: "zh", out: "2022/6/20 – 2022/6/25"},
+		{end: start.Add(90 * time.Minute), skeleton: "Hm", loc: "zh", out: "09:00 – 10:30"},
+	}
+	for _, tc := range testCases {
+		if !builtLocale(tc.loc) {
+			continue
+		}
+		loc := tc.loc
+		if loc == "" {
+			loc = "en"
+		}
+		if out, err := datefmt.FormatRange(start, tc.end, tc.skeleton, loc); err != nil || out != tc.out {
+			t.Errorf("FormatRange(%v, %v, %q, %q) = %q, %v, want %q", start, tc.end, tc.skeleton, loc, out, err, tc.out)
+		}
+	}
+
+	for _, skeleton := range []string{"bad!", "MMM d h:mm a", ""} {
+		if out, err := datefmt.FormatRange(start, start.Add(time.Hour), skeleton, "en"); err == nil {
+			t.Errorf("FormatRange(%q) = %q, want error", skeleton, out)
+		}
+	}
+}
+
+func TestLayoutFormatRange(t *testing.T) {
+	start := time.Date(2022, time.June, 20, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		end    time.Time
+		layout string
+		out    string
+	}{
+		{end: start.Add(90 * time.Minute), layout: "MMM d h:mm a", out: "Jun 20 9:00 – 10:30 AM"},
+		{end: start.AddDate(0, 0, 1), layout: "MMM d h:mm a", out: "Jun 20 9:00 AM – Jun 21 9:00 AM"},
+		{end: start.AddDate(0, 0, 5), layout: "yyyy-MM-dd", out: "2022-06-20 – 2022-06-25"},
+	}
+	for _, tc := range testCases {
+		if out := datefmt.NewLayout(tc.layout).FormatRange(start, tc.end); out != tc.out {
+			t.Errorf("FormatRange(%q, %v, %v) = %q, want %q", tc.layout, start, tc.end, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package datefmt
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RelativeUnit is a unit of the relative time, see FormatRelative.
+type RelativeUnit int
+
+const (
+	RelativeSecond RelativeUnit = iota
+	RelativeMinute
+	RelativeHour
+	RelativeDay
+	RelativeWeek
+	RelativeMonth
+	RelativeYear
+)
+
+// RelativeStyle is the style of the relative time, see FormatRelative.
+type RelativeStyle int
+
+const (
+	// RelativeNumeric always formats numbers, e.g. "1 day ago".
+	RelativeNumeric RelativeStyle = iota
+	// RelativeText formats names if there are, e.g. "yesterday" and "now".
+	RelativeText
+)
+
+// defaultRelativeThresholds is the default max values of the units before the next unit is used.
+var defaultRelativeThresholds = [RelativeYear]int{
+	RelativeSecond: 45,
+	RelativeMinute: 45,
+	RelativeHour:   22,
+	RelativeDay:    7,
+	RelativeWeek:   4,
+	RelativeMonth:  11,
+}
+
+// relativeNames is the localized names of the relative time in a width.
+type relativeNames [RelativeYear + 1]relativeUnitNames
+
+type relativeUnitNames struct {
+	// future and past are the patterns of one and other plural forms,
+	// the other form is used if the one form is empty
+	future, past [2]string
+	// text is the names of the values, e.g. -1 is "yesterday"
+	text map[int]string
+}
+
+// relativeNamesEN is the built-in English names by NameWidth.
+var relativeNamesEN = [3]*relativeNames{
+	NameShort: {
+		RelativeSecond: {future: [2]string{"in {0} sec.", "in {0} sec."}, past: [2]string{"{0} sec. ago", "{0} sec. ago"}, text: map[int]string{0: "now"}},
+		RelativeMinute: {future: [2]string{"in {0} min.", "in {0} min."}, past: [2]string{"{0} min. ago", "{0} min. ago"}, text: map[int]string{0: "this minute"}},
+		RelativeHour:   {future: [2]string{"in {0} hr.", "in {0} hr."}, past: [2]string{"{0} hr. ago", "{0} hr. ago"}, text: map[int]string{0: "this hour"}},
+		RelativeDay:    {future: [2]string{"in {0} day", "in {0} days"}, past: [2]string{"{0} day ago", "{0} days ago"}, text: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}},
+		RelativeWeek:   {future: [2]string{"in {0} wk.", "in {0} wk."}, past: [2]string{"{0} wk. ago", "{0} wk. ago"}, text: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."}},
+		RelativeMonth:  {future: [2]string{"in {0} mo.", "in {0} mo."}, past: [2]string{"{0} mo. ago", "{0} mo. ago"}, text: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."}},
+		RelativeYear:   {future: [2]string{"in {0} yr.", "in {0} yr."}, past: [2]string{"{0} yr. ago", "{0} yr. ago"}, text: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."}},
+	},
+	NameLong: {
+		RelativeSecond: {future: [2]string{"in {0} second", "in {0} seconds"}, past: [2]string{"{0} second ago", "{0} seconds ago"}, text: map[int]string{0: "now"}},
+		RelativeMinute: {future: [2]string{"in {0} minute", "in {0} minutes"}, past: [2]string{"{0} minute ago", "{0} minutes ago"}, text: map[int]string{0: "this minute"}},
+		RelativeHour:   {future: [2]string{"in {0} hour", "in {0} hours"}, past: [2]string{"{0} hour ago", "{0} hours ago"}, text: map[int]string{0: "this hour"}},
+		RelativeDay:    {future: [2]string{"in {0} day", "in {0} days"}, past: [2]string{"{0} day ago", "{0} days ago"}, text: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}},
+		RelativeWeek:   {future: [2]string{"in {0} week", "in {0} weeks"}, past: [2]string{"{0} week ago", "{0} weeks ago"}, text: map[int]string{-1: "last week", 0: "this week", 1: "next week"}},
+		RelativeMonth:  {future: [2]string{"in {0} month", "in {0} months"}, past: [2]string{"{0} month ago", "{0} months ago"}, text: map[int]string{-1: "last month", 0: "this month", 1: "next month"}},
+		RelativeYear:   {future: [2]string{"in {0} year", "in {0} years"}, past: [2]string{"{0} year ago", "{0} years ago"}, text: map[int]string{-1: "last year", 0: "this year", 1: "next year"}},
+	},
+	NameNarrow: {
+		RelativeSecond: {future: [2]string{"in {0}s", "in {0}s"}, past: [2]string{"{0}s ago", "{0}s ago"}, text: map[int]string{0: "now"}},
+		RelativeMinute: {future: [2]string{"in {0}m", "in {0}m"}, past: [2]string{"{0}m ago", "{0}m ago"}, text: map[int]string{0: "this minute"}},
+		RelativeHour:   {future: [2]string{"in {0}h", "in {0}h"}, past: [2]string{"{0}h ago", "{0}h ago"}, text: map[int]string{0: "this hour"}},
+		RelativeDay:    {future: [2]string{"in {0}d", "in {0}d"}, past: [2]string{"{0}d ago", "{0}d ago"}, text: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}},
+		RelativeWeek:   {future: [2]string{"in {0}w", "in {0}w"}, past: [2]string{"{0}w ago", "{0}w ago"}, text: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."}},
+		RelativeMonth:  {future: [2]string{"in {0}mo", "in {0}mo"}, past: [2]string{"{0}mo ago", "{0}mo ago"}, text: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."}},
+		RelativeYear:   {future: [2]string{"in {0}y", "in {0}y"}, past: [2]string{"{0}y ago", "{0}y ago"}, text: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."}},
+	},
+}
+
+// FormatRelative formats t relative to now, e.g. "3 minutes ago" or "in 2 days".
+//
+// The unit is the largest one in which the difference is at least 1, unless
+// the difference is below the threshold of the smaller unit, see WithRelativeThreshold.
+// The style, width and locale are set with WithRelativeStyle, WithRelativeWidth
+// and WithLocale, and the digits of the number with WithDigits; the other options
+// of layouts do not apply. English and Chinese names are built in.
+// In RelativeText, days are counted between the dates in the location of now,
+// e.g. yesterday is the date before the date of now.
+func FormatRelative(t, now time.Time, opts ...Option) string {
+	o := defaultOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	unit, n, past := relativeValue(t.Sub(now), &o)
+	names := &o.locale.relativeNames(o.relativeWidth)[unit]
+	if o.relativeStyle == RelativeText && unit == RelativeDay {
+		// days by the dates, e.g. 30 hours ago from 1:00 is 2 days ago, not yesterday
+		days := calendarDays(t, now)
+		n, past = days, days < 0
+		if past {
+			n = -days
+		}
+	}
+	if o.relativeStyle == RelativeText {
+		v := n
+		if past {
+			v = -n
+		}
+		if s, ok := names.text[v]; ok {
+			return s
+		}
+	}
+	patterns := names.future
+	if past {
+		patterns = names.past
+	}
+	pattern := patterns[1]
+	if n == 1 && patterns[0] != "" {
+		pattern = patterns[0]
+	}
+	num := []byte(strconv.Itoa(n))
+	if o.digits != nil {
+		num = o.digits.localize(num, 0, false)
+	}
+	return strings.Replace(pattern, "{0}", string(num), 1)
+}
+
+// calendarDays returns the number of days from the date of now to the date of t
+// in the location of now.
+func calendarDays(t, now time.Time) int {
+	y1, m1, d1 := now.Date()
+	y2, m2, d2 := t.In(now.Location()).Date()
+	d := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Sub(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC))
+	return int(d.Hours() / 24)
+}
+
+// relativeValue returns the unit and the absolute value of d, and whether d is negative.
+func relativeValue(d time.Duration, o *options) (unit RelativeUnit, n int, past bool) {
+	if d < 0 {
+		d, past = -d, true
+	}
+	days := d.Hours() / 24
+	values := [RelativeYear + 1]float64{
+		RelativeSecond: d.Seconds(),
+		RelativeMinute: d.Minutes(),
+		RelativeHour:   d.Hours(),
+		RelativeDay:    days,
+		RelativeWeek:   days / 7,
+		RelativeMonth:  days / (365.2425 / 12),
+		RelativeYear:   days / 365.2425,
+	}
+	for unit = RelativeSecond; unit < RelativeYear; unit++ {
+		if n = int(math.Round(values[unit])); n < o.relativeThresholds[unit] {
+			return unit, n, past
+		}
+	}
+	if n = int(math.Round(values[RelativeYear])); n < 1 {
+		n = 1
+	}
+	return RelativeYear, n, past
+}
+
+// relativeNames returns the names of the relative time in the locale or its parents.
+func (loc Locale) relativeNames(width NameWidth) *relativeNames {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.relative[width] != nil {
+			return data.relative[width]
+		}
+	}
+	return relativeNamesEN[width]
+}
@@ -0,0 +1,92 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleFormatRelative() {
+	now := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.FormatRelative(now.Add(-3*time.Minute), now))
+	fmt.Println(datefmt.FormatRelative(now.AddDate(0, 0, 2), now))
+	fmt.Println(datefmt.FormatRelative(now.AddDate(0, 0, -1), now, datefmt.WithRelativeStyle(datefmt.RelativeText)))
+	fmt.Println(datefmt.FormatRelative(now.AddDate(0, 0, -1), now, datefmt.WithRelativeStyle(datefmt.RelativeText), datefmt.WithLocale("zh-CN")))
+	// Output:
+	// 3 minutes ago
+	// in 2 days
+	// yesterday
+	// 昨天
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	testCases := []struct {
+		d    time.Duration
+		opts []datefmt.Option
+		out  string
+	}{
+		{d: 0, out: "in 0 seconds"},
+		{d: time.Second, out: "in 1 second"},
+		{d: -10 * time.Second, out: "10 seconds ago"},
+		{d: -44 * time.Second, out: "44 seconds ago"},
+		{d: -45 * time.Second, out: "1 minute ago"},
+		{d: 30 * time.Minute, out: "in 30 minutes"},
+		{d: 50 * time.Minute, out: "in 1 hour"},
+		{d: -21 * time.Hour, out: "21 hours ago"},
+		{d: -22 * time.Hour, out: "1 day ago"},
+		{d: 6 * 24 * time.Hour, out: "in 6 days"},
+		{d: 7 * 24 * time.Hour, out: "in 1 week"},
+		{d: -20 * 24 * time.Hour, out: "3 weeks ago"},
+		{d: -27 * 24 * time.Hour, out: "1 month ago"},
+		{d: 300 * 24 * time.Hour, out: "in 10 months"},
+		{d: 330 * 24 * time.Hour, out: "in 1 year"},
+		{d: -1000 * 24 * time.Hour, out: "3 years ago"},
+		{d: -20 * 24 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeThreshold(datefmt.RelativeDay, 31)}, out: "20 days ago"},
+		{d: -20 * time.Second, opts: []datefmt.Option{datefmt.WithRelativeThreshold(datefmt.RelativeSecond, 10)}, out: "0 minutes ago"},
+		{d: 0, opts: []datefmt.Option{datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "now"},
+		{d: 24 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "tomorrow"},
+		{d: 48 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "in 2 days"},
+		{d: -7 * 24 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "last week"},
+		{d: 365 * 24 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeStyle(datefmt.RelativeText), datefmt.WithRelativeWidth(datefmt.NameShort)}, out: "next yr."},
+		{d: -3 * time.Minute, opts: []datefmt.Option{datefmt.WithRelativeWidth(datefmt.NameShort)}, out: "3 min. ago"},
+		{d: 2 * time.Hour, opts: []datefmt.Option{datefmt.WithRelativeWidth(datefmt.NameNarrow)}, out: "in 2h"},
+		{d: -3 * time.Minute, opts: []datefmt.Option{datefmt.WithLocale("zh")}, out: "3分钟前"},
+		{d: 10 * time.Second, opts: []datefmt.Option{datefmt.WithLocale("zh")}, out: "10秒钟后"},
+		{d: 10 * time.Second, opts: []datefmt.Option{datefmt.WithLocale("zh"), datefmt.WithRelativeWidth(datefmt.NameShort)}, out: "10秒后"},
+		{d: 60 * 24 * time.Hour, opts: []datefmt.Option{datefmt.WithLocale("zh-CN")}, out: "2个月后"},
+		{d: -48 * time.Hour, opts: []datefmt.Option{datefmt.WithLocale("zh"), datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "前天"},
+		{d: 0, opts: []datefmt.Option{datefmt.WithLocale("zh"), datefmt.WithRelativeStyle(datefmt.RelativeText)}, out: "现在"},
+		{d: -3 * time.Minute, opts: []datefmt.Option{datefmt.WithLocale("fr")}, out: "3 minutes ago"},
+		{d: -12 * time.Minute, opts: []datefmt.Option{datefmt.WithDigits(datefmt.ArabicIndicDigits)}, out: "١٢ minutes ago"},
+		{d: -3 * time.Minute, opts: []datefmt.Option{datefmt.WithLocale("zh"), datefmt.WithDigits(datefmt.ChineseDigits)}, out: "三分钟前"},
+		{d: -3 * time.Minute, opts: []datefmt.Option{datefmt.WithCalendar(datefmt.Japanese)}, out: "3 minutes ago"},
+	}
+	for _, c := range testCases {
+		if r := datefmt.FormatRelative(now.Add(c.d), now, c.opts...); r != c.out {
+			t.Errorf("FormatRelative(%s) = %s; want %s", c.d, r, c.out)
+		}
+	}
+}
+
+func TestFormatRelativeDays(t *testing.T) {
+	cst := time.FixedZone("CST", 8*3600)
+	testCases := []struct {
+		t, now time.Time
+		out    string
+	}{
+		{t: time.Date(2022, time.June, 18, 19, 0, 0, 0, time.UTC), now: time.Date(2022, time.June, 20, 1, 0, 0, 0, time.UTC), out: "2 days ago"},
+		{t: time.Date(2022, time.June, 19, 1, 0, 0, 0, time.UTC), now: time.Date(2022, time.June, 20, 1, 0, 0, 0, time.UTC), out: "yesterday"},
+		{t: time.Date(2022, time.June, 21, 22, 0, 0, 0, time.UTC), now: time.Date(2022, time.June, 20, 23, 0, 0, 0, time.UTC), out: "tomorrow"},
+		{t: time.Date(2022, time.June, 20, 0, 30, 0, 0, time.UTC), now: time.Date(2022, time.June, 20, 23, 30, 0, 0, time.UTC), out: "today"},
+		// the dates are in the location of now
+		{t: time.Date(2022, time.June, 18, 18, 30, 0, 0, time.UTC), now: time.Date(2022, time.June, 20, 1, 0, 0, 0, cst), out: "yesterday"},
+	}
+	for _, c := range testCases {
+		if r := datefmt.FormatRelative(c.t, c.now, datefmt.WithRelativeStyle(datefmt.RelativeText)); r != c.out {
+			t.Errorf("FormatRelative(%s, %s) = %s; want %s", c.t, c.now, r, c.out)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+package datefmt
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A skeleton is the set of fields of a layout without their order, separators and
+// text, e.g. yMMMd for "MMM d, y" in English and "y年M月d日" in Chinese.
+
+// skeletonsEN is the built-in English patterns by skeleton.
+var skeletonsEN = map[string]string{
+	"Bh":     "h B",
+	"Bhm":    "h:mm B",
+	"Bhms":   "h:mm:ss B",
+	"d":      "d",
+	"E":      "EEE",
+	"EBhm":   "EEE h:mm B",
+	"EBhms":  "EEE h:mm:ss B",
+	"Ed":     "d EEE",
+	"Ehm":    "EEE h:mm a",
+	"EHm":    "EEE HH:mm",
+	"Ehms":   "EEE h:mm:ss a",
+	"EHms":   "EEE HH:mm:ss",
+	"Gy":     "y G",
+	"GyMMM":  "MMM y G",
+	"GyMMMd": "MMM d, y G",
+	"h":      "h a",
+	"H":      "HH",
+	"hm":     "h:mm a",
+	"Hm":     "HH:mm",
+	"hms":    "h:mm:ss a",
+	"Hms":    "HH:mm:ss",
+	"hmsv":   "h:mm:ss a v",
+	"Hmsv":   "HH:mm:ss v",
+	"hmv":    "h:mm a v",
+	"Hmv":    "HH:mm v",
+	"M":      "M",
+	"Md":     "M/d",
+	"MEd":    "EEE, M/d",
+	"MMM":    "MMM",
+	"MMMd":   "MMM d",
+	"MMMEd":  "EEE, MMM d",
+	"MMMMd":  "MMMM d",
+	"ms":     "mm:ss",
+	"y":      "y",
+	"yM":     "M/y",
+	"yMd":    "M/d/y",
+	"yMEd":   "EEE, M/d/y",
+	"yMMM":   "MMM y",
+	"yMMMd":  "MMM d, y",
+	"yMMMEd": "EEE, MMM d, y",
+	"yMMMM":  "MMMM y",
+}
+
+// dateTimeEN is the built-in English pattern joining a date {1} and a time {0}.
+const dateTimeEN = "{1}, {0}"
+
+// SkeletonError describes a problem with a skeleton.
+type SkeletonError struct {
+	Skeleton string
+	Message  string
+}
+
+// Error returns the string representation of a SkeletonError.
+func (e *SkeletonError) Error() string {
+	return "skeleton " + strconv.Quote(e.Skeleton) + ": " + e.Message
+}
+
+// BestPattern returns the layout of the locale which best matches the skeleton,
+// like ICU's DateTimePatternGenerator, e.g. "MMM d, y" for yMMMd in English and
+// "y年M月d日" in Chinese.
+//
+// The letters of the skeleton are the placeholders of layouts, plus L and c for
+// M and E, and j for the preferred hour of the locale. The widths of the fields
+// in the pattern are adjusted to the skeleton, e.g. yMMMMd is "MMMM d, y".
+// The hour cycle of the skeleton is kept, e.g. Km is "K:mm a" and km is "kk:mm",
+// while h and H take the letter of the locale in the same cycle, e.g. K in ja.
+// A skeleton with date and time fields may be matched as a date and a time
+// joined in the pattern of the locale, and fields missing in the patterns of the
+// locale are appended.
+func BestPattern(skeleton string, loc Locale) (*Layout, error) {
+	fields, err := parseSkeleton(skeleton, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, &SkeletonError{Skeleton: skeleton, Message: "no fields"}
+	}
+	var dateFields, timeFields []skeletonField
+	for _, f := range fields {
+		if f.time() {
+			timeFields = append(timeFields, f)
+		} else {
+			dateFields = append(dateFields, f)
+		}
+	}
+	pattern, missing := loc.bestPattern(fields)
+	if missing > 0 && len(dateFields) > 0 && len(timeFields) > 0 {
+		datePattern, _ := loc.bestPattern(dateFields)
+		timePattern, _ := loc.bestPattern(timeFields)
+		pattern = strings.Replace(strings.Replace(loc.dateTimePattern(), "{1}", datePattern, 1), "{0}", timePattern, 1)
+	}
+	return NewLayout(pattern, WithLocale(loc)), nil
+}
+
+// skeletonField is a field of a skeleton.
+type skeletonField struct {
+	kind   byte // the field, which is the letter of the field in skeletons
+	letter byte // the placeholder
+	width  int
+}
+
+// skeletonKinds is the fields of the letters in skeletons and patterns.
+var skeletonKinds = map[byte]byte{
+	'G': 'G', 'y': 'y', 'Y': 'Y', 'r': 'r', 'U': 'U',
+	'M': 'M', 'L': 'M', 'w': 'w', 'W': 'W', 'D': 'D', 'd': 'd', 'F': 'F',
+	'E': 'E', 'c': 'E', 'u': 'u',
+	'a': 'a', 'b': 'B', 'B': 'B', 'h': 'h', 'H': 'h', 'k': 'h', 'K': 'h', 'j': 'h', 'm': 'm', 's': 's', 'S': 'S',
+	'z': 'z', 'Z': 'z', 'X': 'z', 'v': 'z', 'O': 'z', 'V': 'z',
+}
+
+func (f skeletonField) time() bool {
+	switch f.kind {
+	case 'a', 'B', 'h', 'm', 's', 'S', 'z':
+		return true
+	}
+	return false
+}
+
+func (f skeletonField) text() bool {
+	switch f.kind {
+	case 'G', 'U', 'E', 'a', 'B', 'z':
+		return true
+	case 'M':
+		return f.width >= 3
+	}
+	return false
+}
+
+// parseSkeleton returns the fields of the skeleton without the am/pm marker,
+// which comes with the hour.
+func parseSkeleton(skeleton string, loc Locale) ([]skeletonField, error) {
+	var (
+		fields []skeletonField
+		seen   = map[byte]bool{}
+	)
+	for i := 0; i < len(skeleton); i++ {
+		c := skeleton[i]
+		kind, ok := skeletonKinds[c]
+		if !ok {
+			return nil, &SkeletonError{Skeleton: skeleton, Message: "unknown field " + strconv.Quote(skeleton[i:i+1])}
+		}
+		f := skeletonField{kind: kind, letter: c, width: 1}
+		for ; i+1 < len(skeleton) && skeleton[i+1] == c; i++ {
+			f.width++
+		}
+		if seen[kind] {
+			return nil, &SkeletonError{Skeleton: skeleton, Message: "duplicate field " + strconv.Quote(string(c))}
+		}
+		seen[kind] = true
+		switch c {
+		case 'a':
+			continue
+		case 'L':
+			f.letter = 'M'
+		case 'c':
+			f.letter = 'E'
+		case 'j':
+			f.letter = loc.hourLetter()
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func (loc Locale) skeletons() map[string]string {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.skeletons != nil {
+			return data.skeletons
+		}
+	}
+	return skeletonsEN
+}
+
+func (loc Locale) dateTimePattern() string {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.dateTime != "" {
+			return data.dateTime
+		}
+	}
+	return dateTimeEN
+}
+
+func (loc Locale) hourLetter() byte {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.hour != 0 {
+			return data.hour
+		}
+	}
+	return 'h'
+}
+
+// bestPattern returns the pattern of the locale which best matches the fields,
+// and the number of fields missing in the pattern, which are appended to it.
+func (loc Locale) bestPattern(fields []skeletonField) (string, int) {
+	var (
+		skeletons = loc.skeletons()
+		keys      = make([]string, 0, len(skeletons))
+		best      []skeletonField
+		bestKey   string
+		bestScore = -1
+	)
+	for key := range skeletons {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		candidate, _ := parseSkeleton(key, loc)
+		if score := skeletonDistance(candidate, fields); score >= 0 && (bestScore < 0 || score < bestScore) {
+			best, bestKey, bestScore = candidate, key, score
+		}
+	}
+
+	var (
+		pattern string
+		missing []skeletonField
+	)
+	if bestScore >= 0 {
+		pattern = adjustPattern(skeletons[bestKey], best, fields)
+	}
+	for _, f := range fields {
+		if findSkeletonField(best, f.kind) < 0 {
+			missing = append(missing, f)
+		}
+	}
+	for _, f := range missing {
+		field := strings.Repeat(string(f.letter), f.width)
+		if f.kind == 'S' {
+			if i := strings.LastIndexByte(pattern, 's'); i >= 0 {
+				// fraction of the seconds
+				pattern = pattern[:i+1] + "." + field + pattern[i+1:]
+				continue
+			}
+		}
+		if pattern != "" {
+			pattern += " "
+		}
+		pattern += field
+		if f.letter == 'h' || f.letter == 'K' {
+			pattern += " a"
+		}
+	}
+	return pattern, len(missing)
+}
+
+func findSkeletonField(fields []skeletonField, kind byte) int {
+	for i, f := range fields {
+		if f.kind == kind {
+			return i
+		}
+	}
+	return -1
+}
+
+// skeletonDistance returns the distance from the candidate to the requested fields,
+// or -1 if the candidate has a field which is not requested.
+func skeletonDistance(candidate, fields []skeletonField) int {
+	for _, f := range candidate {
+		if findSkeletonField(fields, f.kind) < 0 {
+			return -1
+		}
+	}
+	score := 0
+	for _, f := range fields {
+		i := findSkeletonField(candidate, f.kind)
+		switch {
+		case i < 0:
+			score += 1000
+		case candidate[i].letter != f.letter && (f.kind != 'h' || twelveHour(candidate[i].letter) != twelveHour(f.letter)):
+			score += 100
+		case candidate[i].letter != f.letter:
+			// the same hour cycle with another letter, e.g. h for K
+			score += 50
+		case candidate[i].text() != f.text():
+			score += 10
+		case candidate[i].width != f.width:
+			score++
+		}
+	}
+	return score
+}
+
+// adjustPattern adjusts the widths of the fields in the pattern of the candidate
+// to the requested fields.
+func adjustPattern(pattern string, candidate, fields []skeletonField) string {
+	var (
+		sb      strings.Builder
+		hour    = byte(0) // the requested hour letter
+		hasHour bool      // the pattern has an hour
+		period  bool      // the pattern has a day period
+	)
+	if j := findSkeletonField(fields, 'h'); j >= 0 {
+		hour = fields[j].letter
+	}
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\'' {
+			// quoted text
+			j := i + 1
+			for j < len(pattern) && pattern[j] != '\'' {
+				j++
+			}
+			if j >= len(pattern) {
+				j = len(pattern) - 1
+			}
+			sb.WriteString(pattern[i : j+1])
+			i = j
+			continue
+		}
+		kind, ok := skeletonKinds[c]
+		if !ok {
+			sb.WriteByte(c)
+			continue
+		}
+		f := skeletonField{kind: kind, letter: c, width: 1}
+		for ; i+1 < len(pattern) && pattern[i+1] == c; i++ {
+			f.width++
+		}
+		if kind == 'a' && hour != 0 && !twelveHour(hour) {
+			// no am/pm marker in the 24-hour cycle, along with the space separating it
+			if text := sb.String(); strings.HasSuffix(text, " ") {
+				sb.Reset()
+				sb.WriteString(text[:len(text)-1])
+			} else if i+1 < len(pattern) && pattern[i+1] == ' ' {
+				i++
+			}
+			continue
+		}
+		switch kind {
+		case 'a', 'B':
+			period = true
+		case 'h':
+			hasHour = true
+		}
+		if j := findSkeletonField(fields, kind); j >= 0 {
+			requested := fields[j]
+			k := findSkeletonField(candidate, kind)
+			switch {
+			case kind == 'h':
+				// the requested hour cycle, the letter of the locale is kept for h and H,
+				// e.g. K in ja, and the padding of the locale is kept, e.g. HH:mm
+				if twelveHour(c) != twelveHour(requested.letter) || requested.letter == 'K' || requested.letter == 'k' {
+					c = requested.letter
+				}
+				f.width = maxInt(f.width, requested.width)
+			case kind == 'm' || kind == 's':
+				// keep the padding of the locale, e.g. HH:mm
+				f.width = maxInt(f.width, requested.width)
+			case kind == 'z':
+				// the requested kind of zone, e.g. zzzz for the pattern of hmv
+				c, f.width = requested.letter, requested.width
+			case k >= 0 && candidate[k].text() == f.text() && requested.text() == f.text():
+				f.width = requested.width
+			}
+		}
+		sb.WriteString(strings.Repeat(string(c), f.width))
+	}
+	if hasHour && twelveHour(hour) && !period {
+		// the am/pm marker comes with the 12-hour cycle
+		sb.WriteString(" a")
+	}
+	return sb.String()
+}
+
+// twelveHour reports whether the hour letter is in a 12-hour cycle.
+func twelveHour(c byte) bool {
+	return c == 'h' || c == 'K'
+}
+
+var skeletonLayoutCache sync.Map
+
+// skeletonLayout returns the best layout of the skeleton in the locale, which
+// is cached if the skeleton is valid.
+func skeletonLayout(skeleton string, loc Locale) (*Layout, error) {
+	key := string(loc) + "\x00" + skeleton
+	if v, ok := skeletonLayoutCache.Load(key); ok {
+		return v.(*Layout), nil
+	}
+	l, err := BestPattern(skeleton, loc)
+	if err != nil {
+		return nil, err
+	}
+	v, _ := skeletonLayoutCache.LoadOrStore(key, l)
+	return v.(*Layout), nil
+}
@@ -0,0 +1,95 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleBestPattern() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	l, _ := datefmt.BestPattern("yMMMd", "en")
+	fmt.Println(l, "->", l.Format(t))
+	l, _ = datefmt.BestPattern("yMMMMEEEEdjm", "en")
+	fmt.Println(l, "->", l.Format(t))
+	// Output:
+	// MMM d, y -> Jun 20, 2022
+	// EEEE, MMMM d, y, h:mm a -> Monday, June 20, 2022, 9:49 AM
+}
+
+func TestBestPattern(t *testing.T) {
+	testCases := []struct {
+		skeleton string
+		loc      datefmt.Locale
+		pattern  string
+		err      bool
+	}{
+		{skeleton: "yMMMd", pattern: "MMM d, y"},
+		{skeleton: "yMMMMd", pattern: "MMMM d, y"},
+		{skeleton: "yMd", pattern: "M/d/y"},
+		{skeleton: "yyMMdd", pattern: "MM/dd/yy"},
+		{skeleton: "yyyyMd", pattern: "M/d/yyyy"},
+		{skeleton: "dMMMy", pattern: "MMM d, y"},
+		{skeleton: "yLLL", pattern: "MMM y"},
+		{skeleton: "MMMEEEEd", pattern: "EEEE, MMM d"},
+		{skeleton: "ccc", pattern: "EEE"},
+		{skeleton: "Hm", pattern: "HH:mm"},
+		{skeleton: "hm", pattern: "h:mm a"},
+		{skeleton: "jm", pattern: "h:mm a"},
+		{skeleton: "Bhm", pattern: "h:mm B"},
+		{skeleton: "EBhm", pattern: "E h:mm B"},
+		{skeleton: "jms", pattern: "h:mm:ss a"},
+		{skeleton: "Km", pattern: "K:mm a"},
+		{skeleton: "km", pattern: "kk:mm"},
+		{skeleton: "kms", pattern: "kk:mm:ss"},
+		{skeleton: "HmsSSS", pattern: "HH:mm:ss.SSS"},
+		{skeleton: "Hmz", pattern: "HH:mm z"},
+		{skeleton: "yMMMdHm", pattern: "MMM d, y, HH:mm"},
+		{skeleton: "Ehm", pattern: "E h:mm a"},
+		{skeleton: "GyMMMd", pattern: "MMM d, y G"},
+		{skeleton: "yw", pattern: "y w"},
+		{skeleton: "yMMMd", loc: "en-US", pattern: "MMM d, y"},
+		{skeleton: "yMMMd", loc: "zh-CN", pattern: "y年M月d日"},
+		{skeleton: "yMMMMd", loc: "zh", pattern: "y年M月d日"},
+		{skeleton: "yMd", loc: "zh", pattern: "y/M/d"},
+		{skeleton: "yMMdd", loc: "zh", pattern: "y/MM/dd"},
+		{skeleton: "jm", loc: "zh", pattern: "HH:mm"},
+		{skeleton: "Bhm", loc: "zh", pattern: "Bh:mm"},
+		{skeleton: "Bhm", loc: "ja", pattern: "BK:mm"},
+		{skeleton: "Km", loc: "ja", pattern: "aK:mm"},
+		{skeleton: "hm", loc: "ja", pattern: "aK:mm"},
+		{skeleton: "km", loc: "ja", pattern: "k:mm"},
+		{skeleton: "Hm", loc: "zh", pattern: "HH:mm"},
+		{skeleton: "km", loc: "zh", pattern: "kk:mm"},
+		{skeleton: "Km", loc: "zh", pattern: "aK:mm"},
+		{skeleton: "hm", loc: "zh", pattern: "ah:mm"},
+		{skeleton: "yMMMdjm", loc: "zh", pattern: "y年M月d日 HH:mm"},
+		{skeleton: "", err: true},
+		{skeleton: "yMMMx", err: true},
+		{skeleton: "yMd'", err: true},
+		{skeleton: "yMMMdM", err: true},
+	}
+	for _, tc := range testCases {
+		if !builtLocale(tc.loc) {
+			continue
+		}
+		loc := tc.loc
+		if loc == "" {
+			loc = "en"
+		}
+		l, err := datefmt.BestPattern(tc.skeleton, loc)
+		if tc.err {
+			if err == nil {
+				t.Errorf("BestPattern(%q, %q) = %q, want error", tc.skeleton, loc, l)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("BestPattern(%q, %q): %v", tc.skeleton, loc, err)
+		} else if l.String() != tc.pattern {
+			t.Errorf("BestPattern(%q, %q) = %q, want %q", tc.skeleton, loc, l, tc.pattern)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package datefmt
+
+// Predefined layouts for common standards. The layouts with an equivalent
+// constant in the time package format times in exactly the same way.
+var (
+	ISO8601      = NewLayout("yyyy-MM-dd'T'HH:mm:ssXXX")        // ISO 8601 extended format
+	ISO8601Basic = NewLayout("yyyyMMdd'T'HHmmssXX")             // ISO 8601 basic format
+	RFC3339      = NewLayout("yyyy-MM-dd'T'HH:mm:ssXXX")        // time.RFC3339
+	RFC3339Milli = NewLayout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX")    // RFC 3339 with milliseconds
+	RFC822       = NewLayout("dd MMM yy HH:mm z")               // time.RFC822
+	RFC822Z      = NewLayout("dd MMM yy HH:mm Z")               // time.RFC822Z
+	RFC850       = NewLayout("EEEE, dd-MMM-yy HH:mm:ss z")      // time.RFC850
+	RFC1123      = NewLayout("EEE, dd MMM yyyy HH:mm:ss z")     // time.RFC1123
+	RFC1123Z     = NewLayout("EEE, dd MMM yyyy HH:mm:ss Z")     // time.RFC1123Z
+	RFC2822      = NewLayout("EEE, dd MMM yyyy HH:mm:ss Z")     // RFC 2822 and RFC 5322
+	RubyDate     = NewLayout("EEE MMM dd HH:mm:ss Z yyyy")      // time.RubyDate
+	Kitchen      = NewLayout("h:mma")                           // time.Kitchen
+	HTTPDate     = NewLayout("EEE, dd MMM yyyy HH:mm:ss 'GMT'") // http.TimeFormat, the time must be in UTC
+	SQLDateTime  = NewLayout("yyyy-MM-dd HH:mm:ss")             // SQL DATETIME
+	DateTime     = NewLayout("yyyy-MM-dd HH:mm:ss")             // time.DateTime
+	DateOnly     = NewLayout("yyyy-MM-dd")                      // time.DateOnly
+	TimeOnly     = NewLayout("HH:mm:ss")                        // time.TimeOnly
+)
@@ -0,0 +1,67 @@
+package datefmt_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func TestStandardLayouts(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	times := []time.Time{
+		time.Date(2022, time.June, 20, 9, 49, 10, 123456789, time.UTC),
+		time.Date(2022, time.June, 20, 21, 5, 0, 0, time.FixedZone("CST", 8*3600)),
+		time.Date(1999, time.December, 31, 0, 0, 59, 0, newYork),
+		time.Date(2000, time.January, 1, 12, 30, 0, 0, time.FixedZone("", -(3*3600+30*60))),
+		time.Date(2024, time.February, 29, 23, 59, 59, 999999999, time.FixedZone("", 5*3600+45*60)),
+	}
+	testCases := []struct {
+		l      *datefmt.Layout
+		layout string
+		utc    bool
+	}{
+		{l: datefmt.RFC3339, layout: time.RFC3339},
+		{l: datefmt.ISO8601, layout: time.RFC3339},
+		{l: datefmt.ISO8601Basic, layout: "20060102T150405Z0700"},
+		{l: datefmt.RFC3339Milli, layout: "2006-01-02T15:04:05.000Z07:00"},
+		{l: datefmt.RFC822, layout: time.RFC822},
+		{l: datefmt.RFC822Z, layout: time.RFC822Z},
+		{l: datefmt.RFC850, layout: time.RFC850},
+		{l: datefmt.RFC1123, layout: time.RFC1123},
+		{l: datefmt.RFC1123Z, layout: time.RFC1123Z},
+		{l: datefmt.RFC2822, layout: time.RFC1123Z},
+		{l: datefmt.RubyDate, layout: time.RubyDate},
+		{l: datefmt.Kitchen, layout: time.Kitchen},
+		{l: datefmt.HTTPDate, layout: http.TimeFormat, utc: true},
+		{l: datefmt.SQLDateTime, layout: "2006-01-02 15:04:05"},
+		{l: datefmt.DateTime, layout: "2006-01-02 15:04:05"}, // time.DateTime since Go 1.20
+		{l: datefmt.DateOnly, layout: "2006-01-02"},          // time.DateOnly since Go 1.20
+		{l: datefmt.TimeOnly, layout: "15:04:05"},            // time.TimeOnly since Go 1.20
+	}
+	for _, tc := range testCases {
+		for _, tm := range times {
+			if tc.utc {
+				tm = tm.UTC()
+			}
+			want := tm.Format(tc.layout)
+			out := tc.l.Format(tm)
+			if out != want {
+				t.Errorf("%s.Format(%v) = %q, want %q", tc.l, tm, out, want)
+				continue
+			}
+			parsed, err := tc.l.ParseInLocation(out, tm.Location())
+			if err != nil {
+				t.Errorf("%s.Parse(%q): %v", tc.l, out, err)
+				continue
+			}
+			if s := parsed.Format(tc.layout); s != want {
+				t.Errorf("%s.Parse(%q) = %v, formatted as %q", tc.l, out, parsed, s)
+			}
+		}
+	}
+}
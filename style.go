@@ -0,0 +1,85 @@
+package datefmt
+
+import (
+	"strings"
+	"sync"
+)
+
+// Style is the length of the date or time in a layout of StyleLayout.
+type Style int
+
+const (
+	StyleNone Style = iota // omitted
+	StyleShort
+	StyleMedium
+	StyleLong
+	StyleFull
+)
+
+// stylePatterns is the patterns of a locale by Style, from StyleShort to StyleFull.
+type stylePatterns struct {
+	date     [4]string
+	time     [4]string
+	dateTime [4]string // joining a date {1} and a time {0} by the date style
+}
+
+// stylesEN is the built-in English patterns.
+var stylesEN = &stylePatterns{
+	date:     [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+	time:     [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a zzzz"},
+	dateTime: [4]string{"{1}, {0}", "{1}, {0}", "{1} 'at' {0}", "{1} 'at' {0}"},
+}
+
+func (loc Locale) styles() *stylePatterns {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.styles != nil {
+			return data.styles
+		}
+	}
+	return stylesEN
+}
+
+type styleKey struct {
+	date, time Style
+	loc        Locale
+}
+
+var styleLayoutCache sync.Map
+
+// StyleLayout returns the layout of the date and time styles in the locale, like
+// DateFormat.getDateTimeInstance of Java, e.g. "M/d/yy" for StyleShort dates in
+// English and "y/M/d" in Chinese. The date or the time is omitted with StyleNone.
+func StyleLayout(dateStyle, timeStyle Style, loc Locale) *Layout {
+	key := styleKey{date: dateStyle, time: timeStyle, loc: loc}
+	if v, ok := styleLayoutCache.Load(key); ok {
+		return v.(*Layout)
+	}
+	var (
+		styles  = loc.styles()
+		pattern string
+	)
+	switch {
+	case dateStyle == StyleNone && timeStyle == StyleNone:
+	case dateStyle == StyleNone:
+		pattern = styles.time[timeStyle.index()]
+	case timeStyle == StyleNone:
+		pattern = styles.date[dateStyle.index()]
+	default:
+		pattern = styles.dateTime[dateStyle.index()]
+		pattern = strings.Replace(pattern, "{1}", styles.date[dateStyle.index()], 1)
+		pattern = strings.Replace(pattern, "{0}", styles.time[timeStyle.index()], 1)
+	}
+	v, _ := styleLayoutCache.LoadOrStore(key, NewLayout(pattern, WithLocale(loc)))
+	return v.(*Layout)
+}
+
+// index returns the index of the style in stylePatterns, styles out of range are clamped.
+func (s Style) index() int {
+	if s < StyleShort {
+		return 0
+	}
+	if s > StyleFull {
+		return 3
+	}
+	return int(s - StyleShort)
+}
@@ -0,0 +1,58 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleStyleLayout() {
+	t := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	fmt.Println(datefmt.StyleLayout(datefmt.StyleShort, datefmt.StyleNone, "en-US").Format(t))
+	fmt.Println(datefmt.StyleLayout(datefmt.StyleMedium, datefmt.StyleShort, "en-US").Format(t))
+	// Output:
+	// 6/20/22
+	// Jun 20, 2022, 9:49 AM
+}
+
+func TestStyleLayout(t *testing.T) {
+	tm := time.Date(2022, time.June, 20, 9, 49, 10, 0, time.UTC)
+	testCases := []struct {
+		date, time datefmt.Style
+		loc        datefmt.Locale
+		out        string
+	}{
+		{date: datefmt.StyleShort, loc: "en-US", out: "6/20/22"},
+		{date: datefmt.StyleMedium, loc: "en-US", out: "Jun 20, 2022"},
+		{date: datefmt.StyleLong, loc: "en-US", out: "June 20, 2022"},
+		{date: datefmt.StyleFull, loc: "en-US", out: "Monday, June 20, 2022"},
+		{time: datefmt.StyleShort, loc: "en-US", out: "9:49 AM"},
+		{time: datefmt.StyleMedium, loc: "en-US", out: "9:49:10 AM"},
+		{time: datefmt.StyleLong, loc: "en-US", out: "9:49:10 AM UTC"},
+		{date: datefmt.StyleShort, time: datefmt.StyleShort, loc: "en-US", out: "6/20/22, 9:49 AM"},
+		{date: datefmt.StyleLong, time: datefmt.StyleShort, loc: "en-US", out: "June 20, 2022 at 9:49 AM"},
+		{date: datefmt.StyleFull, time: datefmt.StyleMedium, loc: "en", out: "Monday, June 20, 2022 at 9:49:10 AM"},
+		{date: datefmt.StyleShort, loc: "zh-CN", out: "2022/6/20"},
+		{date: datefmt.StyleMedium, loc: "zh-CN", out: "2022年6月20日"},
+		{date: datefmt.StyleLong, loc: "zh", out: "2022年6月20日"},
+		{time: datefmt.StyleShort, loc: "zh-CN", out: "09:49"},
+		{time: datefmt.StyleLong, loc: "zh-CN", out: "UTC 09:49:10"},
+		{date: datefmt.StyleShort, time: datefmt.StyleMedium, loc: "zh-CN", out: "2022/6/20 09:49:10"},
+		{date: datefmt.StyleLong, time: datefmt.StyleShort, loc: "zh-CN", out: "2022年6月20日 09:49"},
+		{date: datefmt.StyleFull, loc: "zh-CN", out: "2022年6月20日星期一"},
+		{date: datefmt.StyleShort, loc: "ja", out: "2022/06/20"},
+		{date: datefmt.StyleFull, loc: "ja-JP", out: "2022年6月20日月曜日"},
+		{time: datefmt.StyleShort, loc: "ja", out: "9:49"},
+		{loc: "en", out: ""},
+	}
+	for _, tc := range testCases {
+		if !builtLocale(tc.loc) {
+			continue
+		}
+		if out := datefmt.StyleLayout(tc.date, tc.time, tc.loc).Format(tm); out != tc.out {
+			t.Errorf("StyleLayout(%d, %d, %q).Format(%v) = %q, want %q", tc.date, tc.time, tc.loc, tm, out, tc.out)
+		}
+	}
+}
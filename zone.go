@@ -0,0 +1,462 @@
+package datefmt
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Time zone names are formatted by the placeholders
+//
+//	zzzz   specific non-location name, e.g. Pacific Standard Time
+//	v      short generic non-location name, e.g. PT
+//	vvvv   generic non-location name, e.g. Pacific Time
+//	O      short localized GMT format, e.g. GMT-8
+//	OOOO   localized GMT format, e.g. GMT-08:00
+//	V      short time zone ID, e.g. uslax
+//	VV     time zone ID, e.g. America/Los_Angeles
+//	VVV    exemplar city, e.g. Los Angeles
+//	VVVV   generic location name, e.g. Los Angeles Time
+//
+// and z to zzz print the abbreviation of the zone like the time package.
+// The names fall back to the next available form like CLDR, e.g. to the
+// location name and then to the GMT format. The zones and their metazones are
+// generated from CLDR. The zone of time.Local is found by the TZ environment
+// variable or the link /etc/localtime, and it has only the GMT format, and Local
+// for VV, if neither of them names a zone, e.g. on Windows.
+
+// zoneInfo is the locale independent data of a time zone.
+type zoneInfo struct {
+	metazone string // e.g. America_Pacific, the zones sharing the same names
+	short    string // the BCP 47 ID, e.g. uslax
+	region   string // the region which the zone is the primary zone of, e.g. FR for Europe/Paris
+}
+
+// zoneID returns the ID of the location, which is the ID of the zone of the system
+// for time.Local, from the TZ environment variable or the link /etc/localtime
+// like the time package, if it is a known zone.
+func zoneID(loc *time.Location) string {
+	id := loc.String()
+	if id == "Local" {
+		localZoneOnce.Do(initLocalZone)
+		if localZone != "" {
+			return localZone
+		}
+	}
+	return id
+}
+
+var (
+	localZoneOnce sync.Once
+	localZone     string
+)
+
+func initLocalZone() {
+	tz, ok := os.LookupEnv("TZ")
+	switch {
+	case !ok:
+		tz, _ = os.Readlink("/etc/localtime")
+	case tz == "":
+		localZone = "UTC"
+		return
+	}
+	tz = strings.TrimPrefix(tz, ":")
+	if i := strings.LastIndex(tz, "zoneinfo/"); i >= 0 {
+		tz = tz[i+len("zoneinfo/"):]
+	}
+	if tz == "" || strings.HasPrefix(tz, "/") {
+		return
+	}
+	if _, err := time.LoadLocation(tz); err == nil {
+		localZone = tz
+	}
+}
+
+// canonicalZone returns the canonical ID of the time zone.
+func canonicalZone(id string) string {
+	if canonical, ok := zoneAliases[id]; ok {
+		return canonical
+	}
+	return id
+}
+
+// zoneNames is the localized names of time zones.
+type zoneNames struct {
+	gmtFormat     string // e.g. GMT{0}
+	gmtZeroFormat string // e.g. GMT
+	hourFormat    string // e.g. +HH:mm;-HH:mm
+	regionFormat  string // e.g. {0} Time
+
+	metazones map[string]*zoneNameSet // by metazone
+	zones     map[string]*zoneNameSet // names of zones instead of their metazone, by ID
+	cities    map[string]string       // exemplar cities by zone ID, derived from the ID if missing
+	regions   map[string]string       // names of regions by code
+
+	parseOnce  sync.Once
+	parseNames []string
+	parseZones []string
+	parseKinds []int
+}
+
+// zoneNameSet is the long and short names of a time zone.
+type zoneNameSet struct {
+	long, short [3]string // generic, standard and daylight names
+}
+
+// The indexes of the names in zoneNameSet.
+const (
+	zoneGeneric = iota
+	zoneStandard
+	zoneDaylight
+)
+
+func (loc Locale) zoneNames() *zoneNames {
+	for ; loc != ""; loc = loc.parent() {
+		if data, ok := locales[loc]; ok && data.zones != nil {
+			return data.zones
+		}
+	}
+	return zoneNamesEN
+}
+
+// zoneKindOffset returns the offset of the zone of t in the standard or daylight
+// time of the year of t.
+func zoneKindOffset(t time.Time, kind int) int {
+	_, jan := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location()).Zone()
+	_, jul := time.Date(t.Year(), time.July, 1, 0, 0, 0, 0, t.Location()).Zone()
+	if (kind == zoneDaylight) == (jan < jul) {
+		return jul
+	}
+	return jan
+}
+
+// zoneDST reports whether t is in daylight saving time, and whether the zone of t
+// observes daylight saving time in the year of t.
+func zoneDST(t time.Time) (dst, observed bool) {
+	_, offset := t.Zone()
+	_, jan := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location()).Zone()
+	_, jul := time.Date(t.Year(), time.July, 1, 0, 0, 0, 0, t.Location()).Zone()
+	standard := jan
+	if jul < jan {
+		// southern hemisphere
+		standard = jul
+	}
+	return offset > standard, jan != jul
+}
+
+// name returns the name of the zone of the given kind, or an empty string.
+func (n *zoneNames) name(id string, kind int, long bool) string {
+	if name := n.zones[id].pick(kind, long); name != "" {
+		return name
+	}
+	return n.metazoneName(id, kind, long)
+}
+
+// metazoneName returns the name of the metazone of the zone, which is the source
+// of the generic names after the generic names of the zone like ICU, e.g. GMT
+// instead of the standard name UTC for Etc/UTC.
+func (n *zoneNames) metazoneName(id string, kind int, long bool) string {
+	return n.metazones[zoneInfos[id].metazone].pick(kind, long)
+}
+
+func (s *zoneNameSet) pick(kind int, long bool) string {
+	switch {
+	case s == nil:
+		return ""
+	case long:
+		return s.long[kind]
+	}
+	return s.short[kind]
+}
+
+func (n *zoneNames) city(id string) string {
+	if city, ok := n.cities[id]; ok {
+		return city
+	}
+	i := strings.LastIndexByte(id, '/')
+	if i < 0 || strings.HasPrefix(id, "Etc/") {
+		return ""
+	}
+	return strings.Replace(id[i+1:], "_", " ", -1)
+}
+
+// location returns the generic location name of the zone, e.g. Los Angeles Time,
+// or an empty string.
+func (n *zoneNames) location(id string) string {
+	name := n.regions[zoneInfos[id].region]
+	if name == "" {
+		name = n.city(id)
+	}
+	if name == "" {
+		return ""
+	}
+	return strings.Replace(n.regionFormat, "{0}", name, 1)
+}
+
+func (n *zoneNames) appendSpecific(p []byte, t time.Time, long bool) []byte {
+	id := canonicalZone(zoneID(t.Location()))
+	kind := zoneStandard
+	if dst, _ := zoneDST(t); dst {
+		kind = zoneDaylight
+	}
+	if name := n.name(id, kind, long); name != "" {
+		return append(p, name...)
+	}
+	_, offset := t.Zone()
+	return n.appendGMT(p, offset, long)
+}
+
+func (n *zoneNames) appendGeneric(p []byte, t time.Time, long bool) []byte {
+	id := canonicalZone(zoneID(t.Location()))
+	if name := n.zones[id].pick(zoneGeneric, long); name != "" {
+		// e.g. HST for Pacific/Honolulu
+		return append(p, name...)
+	}
+	if _, observed := zoneDST(t); !observed {
+		if name := n.metazoneName(id, zoneStandard, long); name != "" {
+			return append(p, name...)
+		}
+	}
+	if name := n.metazoneName(id, zoneGeneric, long); name != "" {
+		return append(p, name...)
+	}
+	return n.appendLocation(p, t, long)
+}
+
+func (n *zoneNames) appendLocation(p []byte, t time.Time, long bool) []byte {
+	if name := n.location(canonicalZone(zoneID(t.Location()))); name != "" {
+		return append(p, name...)
+	}
+	_, offset := t.Zone()
+	return n.appendGMT(p, offset, long)
+}
+
+// appendGMT appends the localized GMT format of the offset, e.g. GMT-08:00,
+// or GMT-8 if not long.
+func (n *zoneNames) appendGMT(p []byte, offset int, long bool) []byte {
+	if offset == 0 {
+		return append(p, n.gmtZeroFormat...)
+	}
+	pattern := n.hourFormat
+	if i := strings.IndexByte(pattern, ';'); i >= 0 {
+		if offset < 0 {
+			pattern = pattern[i+1:]
+		} else {
+			pattern = pattern[:i]
+		}
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+	hour, minute := offset/3600, offset/60%60
+	if !long {
+		pattern = strings.Replace(pattern, "HH", "H", 1)
+		if i := strings.Index(pattern, "mm"); i > 0 && minute == 0 {
+			// GMT-8 instead of GMT-8:00
+			pattern = pattern[:i-1] + pattern[i+2:]
+		}
+	}
+	i := strings.Index(n.gmtFormat, "{0}")
+	p = append(p, n.gmtFormat[:i]...)
+	for j := 0; j < len(pattern); j++ {
+		switch {
+		case strings.HasPrefix(pattern[j:], "HH"):
+			p = formatNum(p, hour, 2)
+			j++
+		case pattern[j] == 'H':
+			p = formatNum(p, hour, 1)
+		case strings.HasPrefix(pattern[j:], "mm"):
+			p = formatNum(p, minute, 2)
+			j++
+		default:
+			p = append(p, pattern[j])
+		}
+	}
+	return append(p, n.gmtFormat[i+3:]...)
+}
+
+// formatZone formats the time zone of t for the placeholders zzzz, v, O and V.
+func (l *Layout) formatZone(p []byte, t time.Time, arg *formatArg) []byte {
+	n := l.opts.locale.zoneNames()
+	long := arg.w >= 4
+	switch arg.s[0] {
+	case 'z':
+		return n.appendSpecific(p, t, long)
+	case 'v':
+		return n.appendGeneric(p, t, long)
+	case 'O':
+		_, offset := t.Zone()
+		return n.appendGMT(p, offset, long)
+	}
+	id := canonicalZone(zoneID(t.Location()))
+	switch arg.w {
+	case 1:
+		if info, ok := zoneInfos[id]; ok {
+			return append(p, info.short...)
+		}
+		return append(p, "unk"...)
+	case 2:
+		return append(p, zoneID(t.Location())...)
+	case 3:
+		if city := n.city(id); city != "" {
+			return append(p, city...)
+		}
+		return append(p, zoneID(t.Location())...)
+	}
+	return n.appendLocation(p, t, true)
+}
+
+// z v O V Zone
+
+func parseZone(ps *parser, value string, w int) (string, error) {
+	n := ps.l.opts.locale.zoneNames()
+	if rest, err := n.parseGMT(ps, value); err == nil {
+		return rest, nil
+	}
+	n.parseOnce.Do(n.initParse)
+	i, rest, ok := lookupName(value, n.parseNames)
+	if !ok {
+		return value, errBad
+	}
+	ps.f.ZoneID = n.parseZones[i]
+	ps.f.zoneKind = n.parseKinds[i]
+	ps.f.present |= FieldZoneID
+	return rest, nil
+}
+
+// initParse collects the names of all zones for parsing. A name shared by zones
+// is resolved to the zone of its metazone in the world, and left out if it is
+// not a name of a metazone, or is shared by metazones.
+func (n *zoneNames) initParse() {
+	type zoneName struct {
+		id   string
+		kind int
+	}
+	// add adds the name of the zone, which is ambiguous if it is a name of
+	// another zone, or the one of the lesser kind if it is of the same zone
+	add := func(names map[string]zoneName, ambiguous map[string]bool, name, id string, kind int) {
+		z, ok := names[name]
+		switch {
+		case name == "":
+		case !ok || (z.id == id && kind < z.kind):
+			names[name] = zoneName{id, kind}
+		case z.id != id:
+			ambiguous[name] = true
+		}
+	}
+	names, ambiguous := map[string]zoneName{}, map[string]bool{}
+	for id := range zoneInfos {
+		add(names, ambiguous, n.location(id), id, zoneGeneric)
+		add(names, ambiguous, n.city(id), id, zoneGeneric)
+		for kind := range [3]int{} {
+			add(names, ambiguous, n.name(id, kind, true), id, kind)
+			add(names, ambiguous, n.name(id, kind, false), id, kind)
+		}
+	}
+	metazoneNames, metazoneAmbiguous := map[string]zoneName{}, map[string]bool{}
+	for metazone, id := range metazoneZones {
+		for kind := range [3]int{} {
+			add(metazoneNames, metazoneAmbiguous, n.metazones[metazone].pick(kind, true), id, kind)
+			add(metazoneNames, metazoneAmbiguous, n.metazones[metazone].pick(kind, false), id, kind)
+		}
+	}
+	for name, z := range metazoneNames {
+		names[name], ambiguous[name] = z, metazoneAmbiguous[name]
+	}
+	for name := range names {
+		if !ambiguous[name] {
+			n.parseNames = append(n.parseNames, name)
+		}
+	}
+	sort.Strings(n.parseNames)
+	for _, name := range n.parseNames {
+		n.parseZones = append(n.parseZones, names[name].id)
+		n.parseKinds = append(n.parseKinds, names[name].kind)
+	}
+}
+
+// lookupSpecific returns the zone and the kind of the specific name, e.g. PDT.
+func (n *zoneNames) lookupSpecific(name string) (string, int, bool) {
+	n.parseOnce.Do(n.initParse)
+	i := sort.SearchStrings(n.parseNames, name)
+	if i < len(n.parseNames) && n.parseNames[i] == name && n.parseKinds[i] != zoneGeneric {
+		return n.parseZones[i], n.parseKinds[i], true
+	}
+	return "", 0, false
+}
+
+// parseGMT parses the localized GMT format.
+func (n *zoneNames) parseGMT(ps *parser, value string) (string, error) {
+	i := strings.Index(n.gmtFormat, "{0}")
+	prefix, suffix := n.gmtFormat[:i], n.gmtFormat[i+3:]
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return value, errBad
+	}
+	rest := value[len(prefix):]
+	if rest == "" || (rest[0] != '+' && rest[0] != '-') {
+		if !strings.HasPrefix(value, n.gmtZeroFormat) {
+			return value, errBad
+		}
+		ps.f.ZoneOffset = 0
+		ps.f.present |= FieldZoneOffset
+		return value[len(n.gmtZeroFormat):], nil
+	}
+	sign := rest[0]
+	hour, k, ok := getNum(rest[1:], 2, false)
+	if !ok || hour > 23 {
+		return value, errBad
+	}
+	rest = rest[1+k:]
+	minute := 0
+	if len(rest) > 2 && rest[0] == ':' && isDigit(rest[1]) {
+		if minute, k, ok = getNum(rest[1:], 2, true); !ok || minute > 59 {
+			return value, errBad
+		}
+		rest = rest[1+k:]
+	}
+	if !strings.HasPrefix(rest, suffix) {
+		return value, errBad
+	}
+	ps.setZoneOffset(sign, hour, minute)
+	return rest[len(suffix):], nil
+}
+
+func parseZoneGMT(ps *parser, value string, w int) (string, error) {
+	return ps.l.opts.locale.zoneNames().parseGMT(ps, value)
+}
+
+func parseZoneID(ps *parser, value string, w int) (string, error) {
+	if w == 1 {
+		n := 0
+		for n < len(value) && isLetter(value[n]) {
+			n++
+		}
+		for id, info := range zoneInfos {
+			if strings.EqualFold(value[:n], info.short) {
+				ps.f.ZoneID, ps.f.zoneKind = id, zoneGeneric
+				ps.f.present |= FieldZoneID
+				return value[n:], nil
+			}
+		}
+		return value, errBad
+	}
+	if w != 2 {
+		rest, err := parseZone(ps, value, w)
+		if err == nil || w != 3 {
+			return rest, err
+		}
+		// the ID of a zone without an exemplar city, e.g. UTC
+	}
+	n := 0
+	for n < len(value) && (isLetter(value[n]) || isDigit(value[n]) || strings.IndexByte("/_+-", value[n]) >= 0) {
+		n++
+	}
+	if n == 0 {
+		return value, errBad
+	}
+	ps.f.ZoneID, ps.f.zoneKind = value[:n], zoneGeneric
+	ps.f.present |= FieldZoneID
+	return value[n:], nil
+}
@@ -0,0 +1,215 @@
+package datefmt_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nomango/datefmt"
+)
+
+func ExampleLayout_zone() {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		return
+	}
+	t := time.Date(2022, time.January, 20, 9, 30, 0, 0, la)
+	for _, layout := range []string{"zzzz", "v", "vvvv", "VVVV", "OOOO", "VV"} {
+		fmt.Println(datefmt.NewLayout("h:mm a " + layout).Format(t))
+	}
+	// Output:
+	// 9:30 AM Pacific Standard Time
+	// 9:30 AM PT
+	// 9:30 AM Pacific Time
+	// 9:30 AM Los Angeles Time
+	// 9:30 AM GMT-08:00
+	// 9:30 AM America/Los_Angeles
+}
+
+func TestZoneNames(t *testing.T) {
+	const layout = "zzzz | v | vvvv | O | OOOO | V | VV | VVV | VVVV"
+	testCases := []struct {
+		zone   string
+		locale datefmt.Locale
+		winter string
+		summer string
+	}{
+		{
+			zone:   "America/Los_Angeles",
+			winter: "Pacific Standard Time | PT | Pacific Time | GMT-8 | GMT-08:00 | uslax | America/Los_Angeles | Los Angeles | Los Angeles Time",
+			summer: "Pacific Daylight Time | PT | Pacific Time | GMT-7 | GMT-07:00 | uslax | America/Los_Angeles | Los Angeles | Los Angeles Time",
+		},
+		{
+			zone:   "America/Phoenix",
+			winter: "Mountain Standard Time | MST | Mountain Standard Time | GMT-7 | GMT-07:00 | usphx | America/Phoenix | Phoenix | Phoenix Time",
+			summer: "Mountain Standard Time | MST | Mountain Standard Time | GMT-7 | GMT-07:00 | usphx | America/Phoenix | Phoenix | Phoenix Time",
+		},
+		{
+			zone:   "Europe/London",
+			winter: "Greenwich Mean Time | United Kingdom Time | United Kingdom Time | GMT | GMT | gblon | Europe/London | London | United Kingdom Time",
+			summer: "British Summer Time | United Kingdom Time | United Kingdom Time | GMT+1 | GMT+01:00 | gblon | Europe/London | London | United Kingdom Time",
+		},
+		{
+			zone:   "Asia/Kolkata",
+			winter: "India Standard Time | India Time | India Standard Time | GMT+5:30 | GMT+05:30 | inccu | Asia/Kolkata | Kolkata | India Time",
+			summer: "India Standard Time | India Time | India Standard Time | GMT+5:30 | GMT+05:30 | inccu | Asia/Kolkata | Kolkata | India Time",
+		},
+		{
+			zone:   "Australia/Sydney",
+			winter: "Australian Eastern Daylight Time | Sydney Time | Eastern Australia Time | GMT+11 | GMT+11:00 | ausyd | Australia/Sydney | Sydney | Sydney Time",
+			summer: "Australian Eastern Standard Time | Sydney Time | Eastern Australia Time | GMT+10 | GMT+10:00 | ausyd | Australia/Sydney | Sydney | Sydney Time",
+		},
+		{
+			zone:   "UTC",
+			winter: "Coordinated Universal Time | GMT | GMT | GMT | GMT | utc | UTC | UTC | GMT",
+			summer: "Coordinated Universal Time | GMT | GMT | GMT | GMT | utc | UTC | UTC | GMT",
+		},
+		{
+			zone:   "Asia/Tokyo",
+			winter: "Japan Standard Time | Japan Time | Japan Standard Time | GMT+9 | GMT+09:00 | jptyo | Asia/Tokyo | Tokyo | Japan Time",
+		},
+		{
+			zone:   "Pacific/Honolulu",
+			winter: "Hawaii-Aleutian Standard Time | HST | Hawaii-Aleutian Standard Time | GMT-10 | GMT-10:00 | ushnl | Pacific/Honolulu | Honolulu | Honolulu Time",
+		},
+		{
+			zone:   "Europe/Madrid",
+			winter: "Central European Standard Time | Spain Time | Central European Time | GMT+1 | GMT+01:00 | esmad | Europe/Madrid | Madrid | Spain Time",
+			summer: "Central European Summer Time | Spain Time | Central European Time | GMT+2 | GMT+02:00 | esmad | Europe/Madrid | Madrid | Spain Time",
+		},
+		{
+			zone:   "Africa/Nairobi",
+			locale: "zh",
+			winter: "东部非洲时间 | 肯尼亚时间 | 东部非洲时间 | GMT+3 | GMT+03:00 | kenbo | Africa/Nairobi | Nairobi | 肯尼亚时间",
+		},
+		{
+			zone:   "America/Los_Angeles",
+			locale: "zh",
+			winter: "北美太平洋标准时间 | 洛杉矶时间 | 北美太平洋时间 | GMT-8 | GMT-08:00 | uslax | America/Los_Angeles | 洛杉矶 | 洛杉矶时间",
+			summer: "北美太平洋夏令时间 | 洛杉矶时间 | 北美太平洋时间 | GMT-7 | GMT-07:00 | uslax | America/Los_Angeles | 洛杉矶 | 洛杉矶时间",
+		},
+		{
+			zone:   "Europe/London",
+			locale: "zh-CN",
+			summer: "英国夏令时间 | 英国时间 | 英国时间 | GMT+1 | GMT+01:00 | gblon | Europe/London | 伦敦 | 英国时间",
+		},
+		{
+			zone:   "Asia/Shanghai",
+			locale: "zh",
+			winter: "中国标准时间 | 中国时间 | 中国标准时间 | GMT+8 | GMT+08:00 | cnsha | Asia/Shanghai | 上海 | 中国时间",
+		},
+		{
+			zone:   "America/New_York",
+			locale: "ja",
+			winter: "アメリカ東部標準時 | ニューヨーク時間 | アメリカ東部時間 | GMT-5 | GMT-05:00 | usnyc | America/New_York | ニューヨーク | ニューヨーク時間",
+		},
+		{
+			zone:   "Asia/Tokyo",
+			locale: "ja",
+			winter: "日本標準時 | JST | 日本標準時 | GMT+9 | GMT+09:00 | jptyo | Asia/Tokyo | 東京 | 日本時間",
+		},
+		{
+			zone:   "UTC",
+			locale: "ja",
+			winter: "協定世界時 | GMT | GMT | GMT | GMT | utc | UTC | UTC | GMT",
+		},
+	}
+	for _, c := range testCases {
+		if !builtLocale(c.locale) {
+			continue
+		}
+		loc, err := time.LoadLocation(c.zone)
+		if err != nil {
+			t.Skip(err)
+		}
+		l := datefmt.NewLayout(layout, datefmt.WithLocale(c.locale))
+		for _, tc := range []struct {
+			t   time.Time
+			out string
+		}{
+			{t: time.Date(2022, time.January, 20, 12, 0, 0, 0, loc), out: c.winter},
+			{t: time.Date(2022, time.July, 20, 12, 0, 0, 0, loc), out: c.summer},
+		} {
+			if tc.out == "" {
+				continue
+			}
+			if out := l.Format(tc.t); out != tc.out {
+				t.Errorf("Format(%q, %q, %v) = %q, want %q", c.zone, c.locale, tc.t, out, tc.out)
+			}
+		}
+	}
+}
+
+func TestZoneNamesParse(t *testing.T) {
+	zones := []string{"America/Los_Angeles", "America/Phoenix", "Europe/London", "Asia/Kolkata", "Australia/Sydney", "Asia/Tokyo", "UTC", "Europe/Madrid", "Pacific/Honolulu"}
+	for _, zone := range zones {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Skip(err)
+		}
+		for _, tm := range []time.Time{
+			time.Date(2022, time.January, 20, 12, 0, 0, 0, loc),
+			time.Date(2022, time.July, 20, 12, 0, 0, 0, loc),
+		} {
+			for _, locale := range []datefmt.Locale{"en", "zh", "ja"} {
+				for _, zoneLayout := range strings.Fields("zzzz v vvvv O OOOO V VV VVV VVVV") {
+					l := datefmt.NewLayout("yyyy-MM-dd HH:mm "+zoneLayout, datefmt.WithLocale(locale))
+					s := l.Format(tm)
+					parsed, err := l.Parse(s)
+					if err != nil {
+						t.Errorf("Parse(%q, %q) error: %v", l, s, err)
+					} else if !parsed.Equal(tm) {
+						t.Errorf("Parse(%q, %q) = %v, want %v", l, s, parsed, tm)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZoneParseLocation(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skip(err)
+	}
+	denver, err := time.LoadLocation("America/Denver")
+	if err != nil {
+		t.Skip(err)
+	}
+	testCases := []struct {
+		layout string
+		locale datefmt.Locale
+		value  string
+		loc    *time.Location
+	}{
+		{layout: "yyyy-MM-dd HH:mm vvvv", value: "2022-07-20 09:30 Pacific Time", loc: la},
+		{layout: "yyyy-MM-dd HH:mm zzzz", value: "2022-07-20 09:30 pacific daylight time", loc: la},
+		{layout: "yyyy-MM-dd HH:mm v", value: "2022-07-20 09:30 PT", loc: la},
+		{layout: "yyyy-MM-dd HH:mm VVVV", value: "2022-07-20 09:30 Los Angeles Time", loc: la},
+		{layout: "yyyy-MM-dd HH:mm VV", value: "2022-07-20 09:30 America/Los_Angeles", loc: la},
+		{layout: "yyyy-MM-dd HH:mm V", value: "2022-07-20 09:30 uslax", loc: la},
+		{layout: "yyyy-MM-dd HH:mm vvvv", locale: "zh", value: "2022-07-20 09:30 北美太平洋时间", loc: la},
+		{layout: "yyyy-MM-dd HH:mm z", value: "2022-07-20 09:30 PDT", loc: la},
+		{layout: "yyyy-MM-dd HH:mm z", value: "2022-07-20 09:30 PST", loc: time.FixedZone("", -8*3600)},
+		{layout: "yyyy-MM-dd HH:mm zzzz", value: "2022-07-20 09:30 Mountain Daylight Time", loc: denver},
+		{layout: "yyyy-MM-dd HH:mm OOOO", value: "2022-07-20 09:30 GMT-07:00", loc: time.FixedZone("", -7*3600)},
+		{layout: "yyyy-MM-dd HH:mm O", value: "2022-07-20 09:30 GMT-7", loc: time.FixedZone("", -7*3600)},
+	}
+	for _, c := range testCases {
+		if !builtLocale(c.locale) {
+			continue
+		}
+		tm, err := datefmt.NewLayout(c.layout, datefmt.WithLocale(c.locale)).Parse(c.value)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) error: %v", c.layout, c.value, err)
+		} else if want := time.Date(2022, time.July, 20, 9, 30, 0, 0, c.loc); !tm.Equal(want) || tm.Location().String() != c.loc.String() {
+			t.Errorf("Parse(%q, %q) = %v, want %v", c.layout, c.value, tm, want)
+		}
+	}
+	for _, value := range []string{"2022-07-20 09:30 Nowhere Time", "2022-07-20 09:30 GMT+25"} {
+		if _, err := datefmt.NewLayout("yyyy-MM-dd HH:mm vvvv").Parse(value); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", value)
+		}
+	}
+}